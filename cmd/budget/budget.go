@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	week    = 7 * 24 * time.Hour
+	percent = 100
+)
+
+// status is the usage status of a budget.
+type status int
+
+const (
+	statusOK status = iota
+	statusWarning
+	statusCritical
+)
+
+func (s status) String() string {
+	switch s {
+	case statusOK:
+		return "ok"
+	case statusWarning:
+		return "warning"
+	case statusCritical:
+		return "critical"
+	}
+
+	return "unknown"
+}
+
+// usage is the consumption of a budget.
+type usage struct {
+	tagBudget
+
+	consumed time.Duration
+	// burnRate is the consumption per week.
+	burnRate time.Duration
+	// exhaustion is the date the budget is projected to be exhausted. It is
+	// zero if the budget is exhausted already or no exhaustion is expected.
+	exhaustion time.Time
+}
+
+func (u usage) remaining() time.Duration {
+	return u.hours - u.consumed
+}
+
+// percentage returns the share of the budget that is used in percent.
+func (u usage) percentage() float64 {
+	return float64(u.consumed) / float64(u.hours) * percent
+}
+
+func (u usage) status(cfg config) status {
+	switch used := u.percentage(); {
+	case used >= float64(cfg.critical):
+		return statusCritical
+	case used >= float64(cfg.warning):
+		return statusWarning
+	default:
+		return statusOK
+	}
+}
+
+func hasTag(tag string) twext.EntryFilter {
+	return func(entry twext.Entry) bool {
+		return slices.Contains(entry.Tags, tag)
+	}
+}
+
+func sum(entries twext.EntryIterator) time.Duration {
+	var total time.Duration
+
+	for entry := range entries {
+		total += entry.Duration()
+	}
+
+	return total
+}
+
+// burnWindow returns the interval the burn rate is calculated for. It spans
+// the configured number of weeks up to now, or up to the end of the budget
+// period if it is over. It never starts before the budget period.
+func burnWindow(b tagBudget, weeks int, now time.Time) twext.Interval {
+	window := twext.Interval{End: now}
+
+	if !b.period.End.IsZero() && b.period.End.Before(now) {
+		window.End = b.period.End
+	}
+
+	window.Start = window.End.Add(-time.Duration(weeks) * week)
+
+	if b.period.Start.After(window.Start) {
+		window.Start = b.period.Start
+	}
+
+	return window
+}
+
+// projectExhaustion returns the date the remaining budget is used up at the
+// given burn rate. It returns the zero time, if nothing is remaining, nothing
+// is burned or the budget period is over.
+func projectExhaustion(u usage, now time.Time) time.Time {
+	periodOver := !u.period.End.IsZero() && !u.period.End.After(now)
+	if u.remaining() <= 0 || u.burnRate <= 0 || periodOver {
+		return time.Time{}
+	}
+
+	weeksLeft := float64(u.remaining()) / float64(u.burnRate)
+
+	return now.Add(time.Duration(weeksLeft * float64(week)))
+}
+
+// calculate returns the usage of all budgets sorted by tag. Only entries
+// within the budget period are taken into account.
+func calculate(entries twext.Entries, cfg config) []usage {
+	now := twext.Now().In(cfg.location)
+	result := make([]usage, 0, len(cfg.budgets))
+
+	for _, b := range cfg.budgets {
+		tagged := hasTag(b.tag).Filter(entries.All())
+		u := usage{
+			tagBudget: b,
+			consumed:  sum(twext.ClipEntries(tagged, b.period)),
+		}
+
+		window := burnWindow(b, cfg.burnWeeks, now)
+		if window.Duration() > 0 {
+			burned := sum(twext.ClipEntries(tagged, window))
+			u.burnRate = time.Duration(
+				float64(burned) / float64(window.Duration()) * float64(week),
+			)
+		}
+
+		u.exhaustion = projectExhaustion(u, now)
+		result = append(result, u)
+	}
+
+	slices.SortFunc(result, func(a, b usage) int {
+		return cmp.Compare(a.tag, b.tag)
+	})
+
+	return result
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix    = "budget"
+	configKeyTag       = "tag"
+	configKeyBurnWeeks = "burn_weeks"
+	defaultBurnWeeks   = "4"
+	configKeyWarning   = "warning"
+	defaultWarning     = "80"
+	configKeyCritical  = "critical"
+	defaultCritical    = "100"
+	configKeyTimezone  = "timezone"
+	defaultTimezone    = "local"
+	defaultColor       = "on"
+)
+
+var (
+	errNoBudgets         = errors.New("no budgets configured")
+	errInvalidBudget     = errors.New("invalid budget")
+	errNotPositive       = errors.New("must be positive")
+	errInvalidThresholds = errors.New("warning must not exceed critical")
+)
+
+// tagBudget is the hour budget of a single tag. The period is open if no
+// validity period is configured.
+type tagBudget struct {
+	tag    string
+	hours  time.Duration
+	period twext.Interval
+}
+
+type config struct {
+	budgets   []tagBudget
+	burnWeeks int
+	warning   int
+	critical  int
+	color     bool
+	location  *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseBudget parses a budget like "120h" or, with validity period,
+// "120h 2025-01-01 2025-03-31". Start and end date are inclusive.
+func parseBudget(
+	value twext.ConfigValue,
+	location *time.Location,
+) (time.Duration, twext.Interval, error) {
+	const (
+		withoutPeriod = 1
+		withPeriod    = 3
+	)
+
+	fields := strings.Fields(value.String())
+	if len(fields) != withoutPeriod && len(fields) != withPeriod {
+		return 0, twext.Interval{}, fmt.Errorf(
+			"%w: expected duration and optional start and end date: %s",
+			errInvalidBudget,
+			value,
+		)
+	}
+
+	hours, err := time.ParseDuration(fields[0])
+	if err != nil {
+		return 0, twext.Interval{}, fmt.Errorf("%w: %w", errInvalidBudget, err)
+	}
+
+	if hours <= 0 {
+		return 0, twext.Interval{}, fmt.Errorf(
+			"%w: duration %s", errNotPositive, hours,
+		)
+	}
+
+	var period twext.Interval
+
+	if len(fields) == withPeriod {
+		period.Start, err = time.ParseInLocation(
+			time.DateOnly, fields[1], location,
+		)
+		if err != nil {
+			return 0, twext.Interval{}, fmt.Errorf(
+				"%w: start: %w", errInvalidBudget, err,
+			)
+		}
+
+		end, err := time.ParseInLocation(time.DateOnly, fields[2], location)
+		if err != nil {
+			return 0, twext.Interval{}, fmt.Errorf(
+				"%w: end: %w", errInvalidBudget, err,
+			)
+		}
+
+		period.End = end.AddDate(0, 0, 1)
+
+		if !period.Start.Before(period.End) {
+			return 0, twext.Interval{}, fmt.Errorf(
+				"%w: end before start", errInvalidBudget,
+			)
+		}
+	}
+
+	return hours, period, nil
+}
+
+func readBudgets(
+	rawCfg twext.Config,
+	location *time.Location,
+) ([]tagBudget, error) {
+	var budgets []tagBudget
+
+	prefix := configKey(configKeyTag)
+
+	for key, value := range rawCfg {
+		tag, match := key.SubKey(prefix)
+		if !match || tag == "" {
+			continue
+		}
+
+		hours, period, err := parseBudget(value, location)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		budgets = append(budgets, tagBudget{
+			tag:    tag.String(),
+			hours:  hours,
+			period: period,
+		})
+	}
+
+	if len(budgets) == 0 {
+		return nil, fmt.Errorf("%w: set %s.<tag>", errNoBudgets, prefix)
+	}
+
+	return budgets, nil
+}
+
+func parsePositiveInt(value twext.ConfigValue) (int, error) {
+	i, err := value.Int()
+	if err != nil {
+		return 0, fmt.Errorf("convert to int: %w", err)
+	}
+
+	if i <= 0 {
+		return 0, fmt.Errorf("%w: %d", errNotPositive, i)
+	}
+
+	return i, nil
+}
+
+func parseColor(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	budgets, err := readBudgets(rawCfg, location)
+	if err != nil {
+		return config{}, fmt.Errorf("get budgets: %w", err)
+	}
+
+	burnWeeks, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyBurnWeeks),
+		defaultBurnWeeks,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get burn weeks: %w", err)
+	}
+
+	warning, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyWarning),
+		defaultWarning,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get warning threshold: %w", err)
+	}
+
+	critical, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyCritical),
+		defaultCritical,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get critical threshold: %w", err)
+	}
+
+	if warning > critical {
+		return config{}, fmt.Errorf(
+			"%w: %d > %d", errInvalidThresholds, warning, critical,
+		)
+	}
+
+	color, err := twext.ReadConfigValue(
+		rawCfg,
+		twext.ConfigKeyColor,
+		defaultColor,
+		parseColor,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get color: %w", err)
+	}
+
+	cfg := config{
+		budgets:   budgets,
+		burnWeeks: burnWeeks,
+		warning:   warning,
+		critical:  critical,
+		color:     color,
+		location:  location,
+	}
+
+	log.Println("cfg - Budgets:", len(cfg.budgets))
+	log.Println("cfg - BurnWeeks:", cfg.burnWeeks)
+	log.Println("cfg - Warning:", cfg.warning)
+	log.Println("cfg - Critical:", cfg.critical)
+	log.Println("cfg - Color:", cfg.color)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBudget(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		input          twext.ConfigValue
+		expectedHours  time.Duration
+		expectedPeriod twext.Interval
+		expectedErr    error
+	}{
+		{input: "120h", expectedHours: 120 * time.Hour},
+		{
+			input:         " 7h30m  2025-01-01 2025-01-01 ",
+			expectedHours: 450 * time.Minute,
+			expectedPeriod: twext.Interval{
+				Start: time.Date(2025, 1, 1, 0, 0, 0, 0, berlin),
+				End:   time.Date(2025, 1, 2, 0, 0, 0, 0, berlin),
+			},
+		},
+		{input: "", expectedErr: errInvalidBudget},
+		{input: "120", expectedErr: errInvalidBudget},
+		{input: "0h", expectedErr: errNotPositive},
+		{input: "-1h", expectedErr: errNotPositive},
+		{input: "1h 2025-01-01", expectedErr: errInvalidBudget},
+		{input: "1h 2025-01-01 2025-13-01", expectedErr: errInvalidBudget},
+		{input: "1h 01.01.2025 2025-01-02", expectedErr: errInvalidBudget},
+		{input: "1h 2025-01-02 2025-01-01", expectedErr: errInvalidBudget},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			hours, period, err := parseBudget(tt.input, berlin)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedHours, hours)
+			assert.True(t, tt.expectedPeriod.Start.Equal(period.Start))
+			assert.True(t, tt.expectedPeriod.End.Equal(period.End))
+		})
+	}
+}
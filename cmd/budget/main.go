@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for tracking hour budgets per tag.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	printUsage(env.Out, calculate(entries, cfg), cfg)
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "budget",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
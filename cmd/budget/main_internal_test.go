@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour int) time.Time {
+	return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyColor, "off").
+		Config("budget.tag.projA", "40h").
+		Config("budget.tag.projB", "10h 2024-06-01 2024-06-30").
+		Config("budget.tag.proj.C", "20h 2024-07-01 2024-12-31").
+		EntryAt(at(5, 31, 9), at(5, 31, 13), "projB").
+		EntryAt(at(6, 3, 9), at(6, 3, 17), "projA").
+		EntryAt(at(6, 10, 9), at(6, 10, 15), "projB", "meeting").
+		EntryAt(at(6, 17, 9), at(6, 17, 17), "projA").
+		EntryAt(at(6, 20, 9), at(6, 20, 14), "projB").
+		EntryAt(at(6, 28, 9), at(6, 28, 11), "proj.C").
+		EntryAt(at(7, 1, 9), at(7, 1, 17), "projA").
+		EntryAt(at(7, 2, 9), at(7, 2, 13), "proj.C").
+		EntryAt(at(7, 8, 9), at(7, 8, 15), "projA").
+		EntryAt(at(7, 9, 8), at(7, 9, 21), "proj.C")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "no budgets",
+			input:       twexttest.NewInput(testNow).Config("debug", "off"),
+			expectedErr: errNoBudgets,
+		},
+		{
+			name: "invalid budget",
+			input: newTestInput().
+				Config("budget.tag.projD", "10h 2024-01-01"),
+			expectedErr: errInvalidBudget,
+		},
+		{
+			name:        "zero burn weeks",
+			input:       newTestInput().Config("budget.burn_weeks", "0"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name:        "warning above critical",
+			input:       newTestInput().Config("budget.warning", "120"),
+			expectedErr: errInvalidThresholds,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "burn weeks and thresholds",
+			input: newTestInput().
+				Config("budget.burn_weeks", "1").
+				Config("budget.warning", "50").
+				Config("budget.critical", "90"),
+		},
+		{
+			name:  "color",
+			input: newTestInput().Config(twext.ConfigKeyColor, "on"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
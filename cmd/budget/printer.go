@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const (
+	barWidth  = 10
+	ansiReset = "\x1b[0m"
+)
+
+// statusColors are the ANSI color escape sequences per status.
+//
+//nolint:gochecknoglobals
+var statusColors = map[status]string{
+	statusOK:       "\x1b[32m",
+	statusWarning:  "\x1b[33m",
+	statusCritical: "\x1b[31m",
+}
+
+// bar returns a bar showing the used share of the budget. It is full if
+// the budget is exhausted.
+func bar(percentage float64) string {
+	filled := min(int(percentage*barWidth/percent), barWidth)
+
+	return strings.Repeat("█", filled) +
+		strings.Repeat("░", barWidth-filled)
+}
+
+func formatPeriod(u usage) string {
+	if u.period.Start.IsZero() {
+		return ""
+	}
+
+	lastDay := u.period.End.AddDate(0, 0, -1)
+
+	return u.period.Start.Format(time.DateOnly) + ".." +
+		lastDay.Format(time.DateOnly)
+}
+
+func formatExhaustion(u usage) string {
+	switch {
+	case u.remaining() <= 0:
+		return "exhausted"
+	case u.exhaustion.IsZero():
+		return "-"
+	default:
+		return u.exhaustion.Format(time.DateOnly)
+	}
+}
+
+func formatStatus(s status, color bool) string {
+	if !color {
+		return s.String()
+	}
+
+	return statusColors[s] + s.String() + ansiReset
+}
+
+func printUsage(w io.Writer, usages []usage, cfg config) {
+	table := output.NewTable(w)
+	table.Line("")
+	table.Row(
+		"tag",
+		"period",
+		"budget",
+		"consumed",
+		"remaining",
+		"used",
+		"",
+		"burn/week",
+		"exhausted",
+		"status",
+	)
+
+	for _, u := range usages {
+		table.Row(
+			u.tag,
+			formatPeriod(u),
+			output.FormatDuration(u.hours),
+			output.FormatDuration(u.consumed),
+			output.FormatDuration(u.remaining()),
+			fmt.Sprintf("%.1f%%", u.percentage()),
+			bar(u.percentage()),
+			output.FormatDuration(u.burnRate),
+			formatExhaustion(u),
+			formatStatus(u.status(cfg), cfg.color),
+		)
+	}
+
+	table.Flush()
+}
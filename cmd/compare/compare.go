@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const untaggedLabel = "(untagged)"
+
+var errRangeConflict = errors.New("report range does not cover all periods")
+
+// comparison holds the tracked time per tag for each period.
+type comparison struct {
+	periods []period
+	tags    []string
+	// durations holds the durations per tag, in the order of the periods.
+	durations map[string][]time.Duration
+	totals    []time.Duration
+}
+
+// value returns the duration of the given tag in the period with the given
+// index. If average is true, it is the average per unit of the period.
+func (c comparison) value(tag string, idx int, average bool) time.Duration {
+	return perUnit(c.durations[tag][idx], c.periods[idx], average)
+}
+
+// total returns the total duration of the period with the given index. If
+// average is true, it is the average per unit of the period.
+func (c comparison) total(idx int, average bool) time.Duration {
+	return perUnit(c.totals[idx], c.periods[idx], average)
+}
+
+// hasMultiUnitPeriod returns true if any period spans more than one unit.
+func (c comparison) hasMultiUnitPeriod() bool {
+	return slices.ContainsFunc(c.periods, func(p period) bool {
+		return p.units > 1
+	})
+}
+
+func perUnit(d time.Duration, p period, average bool) time.Duration {
+	if !average {
+		return d
+	}
+
+	return d / time.Duration(p.units)
+}
+
+func entryTags(entry twext.Entry) []string {
+	if len(entry.Tags) == 0 {
+		return []string{untaggedLabel}
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(entry.Tags)))
+}
+
+// referenceTime returns the time relative periods are resolved against. It
+// is the last instant of the report range, if the range ends in the past.
+// Otherwise, it is the given current time.
+func referenceTime(reportRange twext.Interval, now time.Time) time.Time {
+	if reportRange.End.IsZero() || !reportRange.End.Before(now) {
+		return now
+	}
+
+	return reportRange.End.Add(-time.Nanosecond)
+}
+
+// resolvePeriods resolves the configured periods against the given time.
+func resolvePeriods(specs []periodSpec, now time.Time) []period {
+	periods := make([]period, 0, len(specs))
+	for _, spec := range specs {
+		periods = append(periods, spec.resolve(now))
+	}
+
+	return periods
+}
+
+// checkCoverage returns an error if any period is not covered by the report
+// range. Timewarrior passes only the entries within the report range, so the
+// time of such periods would be incomplete. Periods may end after the given
+// current time, as nothing is tracked in the future.
+func checkCoverage(
+	periods []period,
+	reportRange twext.Interval,
+	now time.Time,
+) error {
+	for _, p := range periods {
+		start := reportRange.Start
+		if !start.IsZero() && p.interval.Start.Before(start) {
+			return fmt.Errorf(
+				"%w: %s starts before %s; use :all or a wider range",
+				errRangeConflict,
+				p.name,
+				start.In(p.interval.Start.Location()).Format(time.DateOnly),
+			)
+		}
+
+		periodEnd := p.interval.End
+		if periodEnd.After(now) {
+			periodEnd = now
+		}
+
+		end := reportRange.End
+		if !end.IsZero() && periodEnd.After(end) {
+			return fmt.Errorf(
+				"%w: %s ends after %s; use :all or a wider range",
+				errRangeConflict,
+				p.name,
+				end.In(p.interval.End.Location()).Format(time.DateOnly),
+			)
+		}
+	}
+
+	return nil
+}
+
+// compare sums up the tracked time per tag for each period. Entries with
+// multiple tags are accounted for each of their tags.
+func compare(entries twext.Entries, periods []period) comparison {
+	result := comparison{
+		periods:   periods,
+		durations: make(map[string][]time.Duration),
+		totals:    make([]time.Duration, len(periods)),
+	}
+
+	for idx, p := range periods {
+		for entry := range twext.ClipEntries(entries.All(), p.interval) {
+			duration := entry.Duration()
+			result.totals[idx] += duration
+
+			for _, tag := range entryTags(entry) {
+				if _, exists := result.durations[tag]; !exists {
+					result.durations[tag] = make([]time.Duration, len(periods))
+					result.tags = append(result.tags, tag)
+				}
+
+				result.durations[tag][idx] += duration
+			}
+		}
+	}
+
+	slices.Sort(result.tags)
+
+	return result
+}
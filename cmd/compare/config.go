@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "compare"
+	configKeyPeriods  = "periods"
+	defaultPeriods    = "this:w,last:4w"
+	configKeyAverage  = "average"
+	defaultAverage    = "on"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+type config struct {
+	periods     []periodSpec
+	average     bool
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseBool(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	periods, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyPeriods),
+		defaultPeriods,
+		periodsParser(location),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get periods: %w", err)
+	}
+
+	average, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyAverage),
+		defaultAverage,
+		parseBool,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get average: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	cfg := config{
+		periods:     periods,
+		average:     average,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Periods:", len(cfg.periods))
+	log.Println("cfg - Average:", cfg.average)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for comparing the tracked time per tag
+// across periods.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	now := twext.Now().In(cfg.location)
+	periods := resolvePeriods(cfg.periods, referenceTime(cfg.reportRange, now))
+
+	err := checkCoverage(periods, cfg.reportRange, now)
+	if err != nil {
+		return err
+	}
+
+	printComparison(env.Out, compare(entries, periods), cfg.average)
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "compare",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour int) time.Time {
+	return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		EntryAt(at(6, 3, 9), at(6, 3, 17), "projA").
+		EntryAt(at(6, 11, 9), at(6, 11, 17), "projA").
+		EntryAt(at(6, 12, 9), at(6, 12, 11), "projB", "meeting").
+		EntryAt(at(6, 18, 9), at(6, 18, 15), "projA").
+		EntryAt(at(6, 25, 9), at(6, 25, 17), "projB").
+		EntryAt(at(7, 2, 9), at(7, 2, 13), "projA").
+		EntryAt(at(7, 7, 22), at(7, 8, 2), "projC").
+		EntryAt(at(7, 8, 9), at(7, 8, 17), "projA").
+		EntryAt(at(7, 9, 9), at(7, 9, 12), "projB", "meeting").
+		EntryAt(at(7, 10, 9), time.Time{})
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name: "single period",
+			input: newTestInput().
+				Config("compare.periods", "this:w"),
+			expectedErr: errTooFewPeriods,
+		},
+		{
+			name: "invalid period",
+			input: newTestInput().
+				Config("compare.periods", "this:w,next:w"),
+			expectedErr: errInvalidPeriod,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name:  "total",
+			input: newTestInput().Config("compare.average", "off"),
+		},
+		{
+			name: "absolute periods",
+			input: newTestInput().Config(
+				"compare.periods",
+				"2024-07-01..2024-07-07, 2024-06-01..2024-06-07, last:d",
+			),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240501T000000Z").
+				Config(twext.ConfigKeyReportEnd, "20240701T000000Z"),
+		},
+		{
+			name: "report range starts too late",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240701T000000Z"),
+			expectedErr: errRangeConflict,
+		},
+		{
+			name: "report range ends too early",
+			input: newTestInput().
+				Config("compare.periods", "2024-07-01..2024-07-07,last:w").
+				Config(twext.ConfigKeyReportEnd, "20240705T000000Z"),
+			expectedErr: errRangeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	daysPerWeek     = 7
+	periodSeparator = ","
+	rangeSeparator  = ".."
+	minPeriods      = 2
+)
+
+var (
+	errInvalidPeriod = errors.New("invalid period")
+	errTooFewPeriods = errors.New("at least two periods are required")
+)
+
+//nolint:gochecknoglobals
+var relativePattern = regexp.MustCompile(`^(this|last):([0-9]*)([dwm])$`)
+
+// unit is the calendar unit of a relative period.
+type unit string
+
+const (
+	unitDay   unit = "d"
+	unitWeek  unit = "w"
+	unitMonth unit = "m"
+)
+
+// start returns the start of the unit the given time is in. Weeks start on
+// Monday.
+func (u unit) start(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	switch u {
+	case unitDay:
+		return day
+	case unitWeek:
+		weekday := (int(day.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+		return day.AddDate(0, 0, -weekday)
+	case unitMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+
+	return day
+}
+
+// add adds n units to the given time.
+func (u unit) add(t time.Time, n int) time.Time {
+	switch u {
+	case unitDay:
+		return t.AddDate(0, 0, n)
+	case unitWeek:
+		return t.AddDate(0, 0, n*daysPerWeek)
+	case unitMonth:
+		return t.AddDate(0, n, 0)
+	}
+
+	return t
+}
+
+// periodSpec is the definition of a period. Relative periods are resolved
+// against the current time. Absolute periods have a fixed interval.
+type periodSpec struct {
+	name string
+	// current is true for relative periods that include the current unit.
+	current bool
+	count   int
+	unit    unit
+	fixed   twext.Interval
+}
+
+// period is a resolved period.
+type period struct {
+	name     string
+	interval twext.Interval
+	// units is the number of units the period spans. It is 1 for absolute
+	// periods.
+	units int
+	// unit is the calendar unit of relative periods. It is empty for
+	// absolute periods.
+	unit unit
+}
+
+// label returns the column header of the period. Averaged periods are
+// marked with the unit the average refers to, like "last:4w (avg/w)".
+func (p period) label(average bool) string {
+	if !average || p.units < 2 {
+		return p.name
+	}
+
+	return fmt.Sprintf("%s (avg/%s)", p.name, p.unit)
+}
+
+// resolve returns the period for the given current time. Relative periods
+// starting with "this" span the current unit and the preceding ones, periods
+// starting with "last" span the units before the current one.
+func (s periodSpec) resolve(now time.Time) period {
+	if s.unit == "" {
+		return period{name: s.name, interval: s.fixed, units: 1}
+	}
+
+	end := s.unit.start(now)
+	if s.current {
+		end = s.unit.add(end, 1)
+	}
+
+	return period{
+		name: s.name,
+		interval: twext.Interval{
+			Start: s.unit.add(end, -s.count),
+			End:   end,
+		},
+		units: s.count,
+		unit:  s.unit,
+	}
+}
+
+func parseRelativePeriod(definition string) (periodSpec, bool, error) {
+	match := relativePattern.FindStringSubmatch(definition)
+	if match == nil {
+		return periodSpec{}, false, nil
+	}
+
+	count := 1
+
+	if match[2] != "" {
+		var err error
+
+		count, err = strconv.Atoi(match[2])
+		if err != nil || count < 1 {
+			return periodSpec{}, true, fmt.Errorf(
+				"%w: count must be positive: %s", errInvalidPeriod, definition,
+			)
+		}
+	}
+
+	return periodSpec{
+		name:    definition,
+		current: match[1] == "this",
+		count:   count,
+		unit:    unit(match[3]),
+	}, true, nil
+}
+
+func parseAbsolutePeriod(
+	definition string,
+	location *time.Location,
+) (periodSpec, error) {
+	first, last, found := strings.Cut(definition, rangeSeparator)
+	if !found {
+		return periodSpec{}, fmt.Errorf("%w: %s", errInvalidPeriod, definition)
+	}
+
+	start, err := time.ParseInLocation(time.DateOnly, first, location)
+	if err != nil {
+		return periodSpec{}, fmt.Errorf("%w: start: %w", errInvalidPeriod, err)
+	}
+
+	end, err := time.ParseInLocation(time.DateOnly, last, location)
+	if err != nil {
+		return periodSpec{}, fmt.Errorf("%w: end: %w", errInvalidPeriod, err)
+	}
+
+	if end.Before(start) {
+		return periodSpec{}, fmt.Errorf(
+			"%w: end before start: %s", errInvalidPeriod, definition,
+		)
+	}
+
+	return periodSpec{
+		name:  definition,
+		fixed: twext.Interval{Start: start, End: end.AddDate(0, 0, 1)},
+	}, nil
+}
+
+// periodsParser returns a parser for a comma separated list of periods.
+// Periods are either relative, like "this:w" or "last:4w", or absolute date
+// ranges with inclusive start and end date, like "2024-06-01..2024-06-30".
+func periodsParser(
+	location *time.Location,
+) func(twext.ConfigValue) ([]periodSpec, error) {
+	return func(value twext.ConfigValue) ([]periodSpec, error) {
+		definitions := strings.Split(value.String(), periodSeparator)
+		specs := make([]periodSpec, 0, len(definitions))
+
+		for _, definition := range definitions {
+			definition = strings.TrimSpace(definition)
+
+			spec, relative, err := parseRelativePeriod(definition)
+			if !relative {
+				spec, err = parseAbsolutePeriod(definition, location)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			specs = append(specs, spec)
+		}
+
+		if len(specs) < minPeriods {
+			return nil, fmt.Errorf("%w: %s", errTooFewPeriods, value)
+		}
+
+		return specs, nil
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriodsParser(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       twext.ConfigValue
+		expected    []periodSpec
+		expectedErr error
+	}{
+		{
+			name:  "relative",
+			value: "this:w, last:4w",
+			expected: []periodSpec{
+				{name: "this:w", current: true, count: 1, unit: unitWeek},
+				{name: "last:4w", count: 4, unit: unitWeek},
+			},
+		},
+		{
+			name:  "absolute",
+			value: "2024-07-01..2024-07-31,last:m",
+			expected: []periodSpec{
+				{
+					name: "2024-07-01..2024-07-31",
+					fixed: twext.Interval{
+						Start: date(7, 1),
+						End:   date(8, 1),
+					},
+				},
+				{name: "last:m", count: 1, unit: unitMonth},
+			},
+		},
+		{
+			name:        "single period",
+			value:       "this:w",
+			expectedErr: errTooFewPeriods,
+		},
+		{
+			name:        "zero count",
+			value:       "this:w,last:0w",
+			expectedErr: errInvalidPeriod,
+		},
+		{
+			name:        "unknown unit",
+			value:       "this:w,last:2y",
+			expectedErr: errInvalidPeriod,
+		},
+		{
+			name:        "invalid date",
+			value:       "this:w,2024-07-01..2024-07-32",
+			expectedErr: errInvalidPeriod,
+		},
+		{
+			name:        "end before start",
+			value:       "this:w,2024-07-31..2024-07-01",
+			expectedErr: errInvalidPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := periodsParser(time.UTC)(tt.value)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestPeriodSpec_Resolve(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec     periodSpec
+		expected twext.Interval
+		units    int
+	}{
+		{
+			spec: periodSpec{
+				name:    "this:d",
+				current: true,
+				count:   1,
+				unit:    unitDay,
+			},
+			expected: twext.Interval{Start: date(7, 10), End: date(7, 11)},
+			units:    1,
+		},
+		{
+			spec: periodSpec{
+				name:  "last:3d",
+				count: 3,
+				unit:  unitDay,
+			},
+			expected: twext.Interval{Start: date(7, 7), End: date(7, 10)},
+			units:    3,
+		},
+		{
+			spec: periodSpec{
+				name:    "this:2w",
+				current: true,
+				count:   2,
+				unit:    unitWeek,
+			},
+			expected: twext.Interval{Start: date(7, 1), End: date(7, 15)},
+			units:    2,
+		},
+		{
+			spec: periodSpec{
+				name:  "last:4w",
+				count: 4,
+				unit:  unitWeek,
+			},
+			expected: twext.Interval{Start: date(6, 10), End: date(7, 8)},
+			units:    4,
+		},
+		{
+			spec: periodSpec{
+				name:    "this:m",
+				current: true,
+				count:   1,
+				unit:    unitMonth,
+			},
+			expected: twext.Interval{Start: date(7, 1), End: date(8, 1)},
+			units:    1,
+		},
+		{
+			spec: periodSpec{
+				name:  "last:2m",
+				count: 2,
+				unit:  unitMonth,
+			},
+			expected: twext.Interval{Start: date(5, 1), End: date(7, 1)},
+			units:    2,
+		},
+		{
+			spec: periodSpec{
+				name:  "2024-06-01..2024-06-30",
+				fixed: twext.Interval{Start: date(6, 1), End: date(7, 1)},
+			},
+			expected: twext.Interval{Start: date(6, 1), End: date(7, 1)},
+			units:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec.name, func(t *testing.T) {
+			actual := tt.spec.resolve(now)
+			assert.Equal(t, tt.spec.name, actual.name)
+			assert.Equal(t, tt.expected, actual.interval)
+			assert.Equal(t, tt.units, actual.units)
+		})
+	}
+}
+
+func TestPeriod_Label(t *testing.T) {
+	tests := []struct {
+		name     string
+		period   period
+		average  bool
+		expected string
+	}{
+		{
+			name:     "single unit",
+			period:   period{name: "this:w", units: 1, unit: unitWeek},
+			average:  true,
+			expected: "this:w",
+		},
+		{
+			name:     "multi unit averaged",
+			period:   period{name: "last:4w", units: 4, unit: unitWeek},
+			average:  true,
+			expected: "last:4w (avg/w)",
+		},
+		{
+			name:     "multi unit total",
+			period:   period{name: "last:4w", units: 4, unit: unitWeek},
+			expected: "last:4w",
+		},
+		{
+			name:     "absolute",
+			period:   period{name: "2024-06-01..2024-06-30", units: 1},
+			average:  true,
+			expected: "2024-06-01..2024-06-30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.period.label(tt.average))
+		})
+	}
+}
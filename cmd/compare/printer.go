@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const (
+	percent       = 100
+	noPercentage  = "n/a"
+	totalLabel    = "total"
+	averageNotice = "Columns marked with avg show the average per unit."
+)
+
+func formatDelta(d time.Duration) string {
+	if d > 0 {
+		return "+" + output.FormatDuration(d)
+	}
+
+	return output.FormatDuration(d)
+}
+
+// formatRelativeDelta formats the delta relative to the base duration in
+// percent. It is not available if the base duration is zero.
+func formatRelativeDelta(delta, base time.Duration) string {
+	if base == 0 {
+		return noPercentage
+	}
+
+	return fmt.Sprintf("%+.1f%%", float64(delta)/float64(base)*percent)
+}
+
+// columns returns the columns for the given durations in period order. The
+// first period is compared with each of the other periods.
+func columns(label string, durations []time.Duration) []string {
+	result := []string{label, output.FormatDuration(durations[0])}
+
+	for _, base := range durations[1:] {
+		delta := durations[0] - base
+		result = append(result,
+			output.FormatDuration(base),
+			formatDelta(delta),
+			formatRelativeDelta(delta, base),
+		)
+	}
+
+	return result
+}
+
+func printComparison(w io.Writer, c comparison, average bool) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	header := []string{"tag", c.periods[0].label(average)}
+	for _, p := range c.periods[1:] {
+		header = append(header, p.label(average), "Δ", "Δ%")
+	}
+
+	table.Row(header...)
+
+	durations := make([]time.Duration, len(c.periods))
+
+	for _, tag := range c.tags {
+		for idx := range c.periods {
+			durations[idx] = c.value(tag, idx, average)
+		}
+
+		table.Row(columns(tag, durations)...)
+	}
+
+	for idx := range c.periods {
+		durations[idx] = c.total(idx, average)
+	}
+
+	table.Row(columns(totalLabel, durations)...)
+
+	if average && c.hasMultiUnitPeriod() {
+		table.Line("")
+		table.Line(averageNotice)
+	}
+
+	table.Flush()
+}
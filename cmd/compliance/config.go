@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix       = "compliance"
+	configKeyMaxDaily     = "max_daily"
+	defaultMaxDaily       = "10h"
+	configKeyMinRest      = "min_rest"
+	defaultMinRest        = "11h"
+	configKeyMaxWeekly    = "max_weekly_average"
+	defaultMaxWeekly      = "48h"
+	configKeyAverageWeeks = "average_weeks"
+	defaultAverageWeeks   = "26"
+	configKeySunday       = "sunday"
+	defaultSunday         = "on"
+	configKeyTimezone     = "timezone"
+	defaultTimezone       = "local"
+)
+
+var (
+	errNegative    = errors.New("duration must not be negative")
+	errNotPositive = errors.New("must be positive")
+)
+
+// config holds the rule thresholds. A threshold of zero disables the rule.
+type config struct {
+	maxDaily     time.Duration
+	minRest      time.Duration
+	maxWeekly    time.Duration
+	averageWeeks int
+	sunday       bool
+	location     *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseNonNegativeDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegative, duration)
+	}
+
+	return duration, nil
+}
+
+func parsePositiveInt(value twext.ConfigValue) (int, error) {
+	i, err := value.Int()
+	if err != nil {
+		return 0, fmt.Errorf("convert to int: %w", err)
+	}
+
+	if i <= 0 {
+		return 0, fmt.Errorf("%w: %d", errNotPositive, i)
+	}
+
+	return i, nil
+}
+
+func parseBool(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	maxDaily, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMaxDaily),
+		defaultMaxDaily,
+		parseNonNegativeDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get max daily: %w", err)
+	}
+
+	minRest, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMinRest),
+		defaultMinRest,
+		parseNonNegativeDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get min rest: %w", err)
+	}
+
+	maxWeekly, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMaxWeekly),
+		defaultMaxWeekly,
+		parseNonNegativeDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get max weekly average: %w", err)
+	}
+
+	averageWeeks, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyAverageWeeks),
+		defaultAverageWeeks,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get average weeks: %w", err)
+	}
+
+	sunday, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeySunday),
+		defaultSunday,
+		parseBool,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get sunday: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		maxDaily:     maxDaily,
+		minRest:      minRest,
+		maxWeekly:    maxWeekly,
+		averageWeeks: averageWeeks,
+		sunday:       sunday,
+		location:     location,
+	}
+
+	log.Println("cfg - MaxDaily:", cfg.maxDaily)
+	log.Println("cfg - MinRest:", cfg.minRest)
+	log.Println("cfg - MaxWeeklyAverage:", cfg.maxWeekly)
+	log.Println("cfg - AverageWeeks:", cfg.averageWeeks)
+	log.Println("cfg - Sunday:", cfg.sunday)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for working time compliance warnings.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	printViolations(env.Out, check(entries, cfg))
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "compliance",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour int) time.Time {
+	return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		EntryAt(at(7, 1, 8), at(7, 1, 19), "projA").
+		EntryAt(at(7, 1, 20), at(7, 1, 23), "release").
+		EntryAt(at(7, 2, 7), at(7, 2, 12), "projA").
+		EntryAt(at(7, 3, 9), at(7, 3, 17), "projB").
+		EntryAt(at(7, 6, 22), at(7, 7, 2), "release").
+		EntryAt(at(7, 8, 9), at(7, 8, 17), "projA").
+		EntryAt(at(7, 10, 9), time.Time{}, "projB")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "negative max daily",
+			input:       newTestInput().Config("compliance.max_daily", "-1h"),
+			expectedErr: errNegative,
+		},
+		{
+			name: "zero average weeks",
+			input: newTestInput().
+				Config("compliance.average_weeks", "0"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "custom rules",
+			input: newTestInput().
+				Config("compliance.max_daily", "0").
+				Config("compliance.min_rest", "12h").
+				Config("compliance.max_weekly_average", "20h").
+				Config("compliance.average_weeks", "1").
+				Config("compliance.sunday", "off"),
+		},
+		{
+			name: "no violations",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				EntryAt(at(7, 1, 9), at(7, 1, 17), "projA").
+				EntryAt(at(7, 2, 9), at(7, 2, 17), "projA"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const noLimit = "-"
+
+func formatDates(v violation) string {
+	first := v.first.Format(time.DateOnly)
+	if v.last.Equal(v.first) {
+		return first
+	}
+
+	return first + ".." + v.last.Format(time.DateOnly)
+}
+
+func formatLimit(v violation) string {
+	if v.limit == 0 {
+		return noLimit
+	}
+
+	return output.FormatDuration(v.limit)
+}
+
+// formatIDs formats the entry IDs like timewarrior does, like "@3 @4".
+func formatIDs(ids []int) string {
+	sorted := slices.Compact(slices.Sorted(slices.Values(ids)))
+	formatted := make([]string, 0, len(sorted))
+
+	for _, id := range sorted {
+		formatted = append(formatted, "@"+strconv.Itoa(id))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func printViolations(w io.Writer, violations []violation) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(violations) == 0 {
+		table.Line("No violations.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row("rule", "dates", "value", "limit", "entries")
+
+	for _, v := range violations {
+		table.Row(
+			v.rule.String(),
+			formatDates(v),
+			output.FormatDuration(v.value),
+			formatLimit(v),
+			formatIDs(v.ids),
+		)
+	}
+
+	table.Line("")
+	table.Line(fmt.Sprintf("Violations: %d", len(violations)))
+	table.Flush()
+}
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const daysPerWeek = 7
+
+// rule is a working time rule. The order of the constants is the order
+// violations on the same date are listed in.
+type rule int
+
+const (
+	ruleMaxDaily rule = iota
+	ruleMinRest
+	ruleMaxWeekly
+	ruleSunday
+)
+
+func (r rule) String() string {
+	switch r {
+	case ruleMaxDaily:
+		return "max daily"
+	case ruleMinRest:
+		return "min rest"
+	case ruleMaxWeekly:
+		return "max weekly average"
+	case ruleSunday:
+		return "sunday"
+	}
+
+	return "unknown"
+}
+
+// violation is a violated rule with the dates and entries involved. Value is
+// the measured duration and limit the configured threshold.
+type violation struct {
+	rule  rule
+	first time.Time
+	last  time.Time
+	value time.Duration
+	limit time.Duration
+	ids   []int
+}
+
+func compareViolations(a, b violation) int {
+	return cmp.Or(a.first.Compare(b.first), cmp.Compare(a.rule, b.rule))
+}
+
+// workday holds the entries starting on the same date.
+type workday struct {
+	date    time.Time
+	start   time.Time
+	end     time.Time
+	firstID int
+	lastID  int
+	tracked time.Duration
+	ids     []int
+}
+
+// add extends the workday by the given entry.
+func (d workday) add(entry twext.Entry) workday {
+	if d.start.IsZero() || entry.Start.Before(d.start) {
+		d.start = entry.Start.Time
+		d.firstID = entry.ID
+	}
+
+	if end := entry.CurrentEnd().Time; end.After(d.end) {
+		d.end = end
+		d.lastID = entry.ID
+	}
+
+	d.tracked += entry.Duration()
+	d.ids = append(d.ids, entry.ID)
+
+	return d
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// weekStart returns the Monday of the week the given date is in.
+func weekStart(date time.Time) time.Time {
+	weekday := (int(date.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+	return date.AddDate(0, 0, -weekday)
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func addEntry(d workday, entry twext.Entry) workday {
+	return d.add(entry)
+}
+
+func isSunday(entry twext.Entry) bool {
+	return entry.Start.Weekday() == time.Sunday
+}
+
+// collectWorkdays groups the entries by the date they start on. Entries are
+// not split at midnight, so night shifts count for the day they start.
+func collectWorkdays(entries twext.EntryIterator) []workday {
+	perDay := twext.Aggregate(entries, startDate, addEntry)
+
+	var days []workday
+
+	for _, d := range perDay.Sorted() {
+		d.date = midnight(d.start)
+		days = append(days, d)
+	}
+
+	return days
+}
+
+func checkMaxDaily(days []workday, limit time.Duration) []violation {
+	var violations []violation
+
+	for _, d := range days {
+		if d.tracked > limit {
+			violations = append(violations, violation{
+				rule:  ruleMaxDaily,
+				first: d.date,
+				last:  d.date,
+				value: d.tracked,
+				limit: limit,
+				ids:   d.ids,
+			})
+		}
+	}
+
+	return violations
+}
+
+// checkMinRest checks the rest between the last end of a workday and the
+// first start of the next one.
+func checkMinRest(days []workday, limit time.Duration) []violation {
+	var violations []violation
+
+	for idx := 1; idx < len(days); idx++ {
+		previous, current := days[idx-1], days[idx]
+
+		rest := max(current.start.Sub(previous.end), 0)
+		if rest < limit {
+			violations = append(violations, violation{
+				rule:  ruleMinRest,
+				first: previous.date,
+				last:  current.date,
+				value: rest,
+				limit: limit,
+				ids:   []int{previous.lastID, current.firstID},
+			})
+		}
+	}
+
+	return violations
+}
+
+// checkMaxWeekly checks the average time per week over the given number of
+// weeks up to each week with entries. Weeks without entries count as zero.
+// The entries of the last week of the window are listed.
+func checkMaxWeekly(
+	days []workday,
+	limit time.Duration,
+	weeks int,
+) []violation {
+	totals := make(map[string]time.Duration)
+	ids := make(map[string][]int)
+
+	var mondays []time.Time
+
+	for _, d := range days {
+		monday := weekStart(d.date)
+		key := monday.Format(time.DateOnly)
+
+		if _, exists := totals[key]; !exists {
+			mondays = append(mondays, monday)
+		}
+
+		totals[key] += d.tracked
+		ids[key] = append(ids[key], d.ids...)
+	}
+
+	var violations []violation
+
+	for _, monday := range mondays {
+		first := monday.AddDate(0, 0, -(weeks-1)*daysPerWeek)
+
+		var sum time.Duration
+		for week := range weeks {
+			key := first.AddDate(0, 0, week*daysPerWeek).Format(time.DateOnly)
+			sum += totals[key]
+		}
+
+		average := sum / time.Duration(weeks)
+		if average > limit {
+			violations = append(violations, violation{
+				rule:  ruleMaxWeekly,
+				first: first,
+				last:  monday.AddDate(0, 0, daysPerWeek-1),
+				value: average,
+				limit: limit,
+				ids:   ids[monday.Format(time.DateOnly)],
+			})
+		}
+	}
+
+	return violations
+}
+
+// checkSunday checks for work on Sundays. Entries are split at midnight, so
+// only the time on Sunday is accounted.
+func checkSunday(entries twext.EntryIterator) []violation {
+	perDay := twext.Aggregate(
+		twext.EntryFilter(isSunday).Filter(twext.SplitAtMidnight(entries)),
+		startDate,
+		addEntry,
+	)
+
+	var violations []violation
+
+	for _, d := range perDay.Sorted() {
+		if d.tracked == 0 {
+			continue
+		}
+
+		violations = append(violations, violation{
+			rule:  ruleSunday,
+			first: midnight(d.start),
+			last:  midnight(d.start),
+			value: d.tracked,
+			ids:   d.ids,
+		})
+	}
+
+	return violations
+}
+
+// check checks all enabled rules and returns the violations sorted by date.
+func check(entries twext.Entries, cfg config) []violation {
+	localEntries := slices.Collect(
+		twext.InLocation(entries.All(), cfg.location),
+	)
+	days := collectWorkdays(slices.Values(localEntries))
+
+	var violations []violation
+
+	if cfg.maxDaily > 0 {
+		violations = append(violations, checkMaxDaily(days, cfg.maxDaily)...)
+	}
+
+	if cfg.minRest > 0 {
+		violations = append(violations, checkMinRest(days, cfg.minRest)...)
+	}
+
+	if cfg.maxWeekly > 0 {
+		violations = append(violations, checkMaxWeekly(
+			days, cfg.maxWeekly, cfg.averageWeeks,
+		)...)
+	}
+
+	if cfg.sunday {
+		violations = append(violations, checkSunday(
+			slices.Values(localEntries),
+		)...)
+	}
+
+	slices.SortStableFunc(violations, compareViolations)
+
+	return violations
+}
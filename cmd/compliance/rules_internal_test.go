@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckMaxWeekly(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	days := []workday{
+		{date: date(7, 1), tracked: 50 * time.Hour, ids: []int{4}},
+		{date: date(7, 9), tracked: 40 * time.Hour, ids: []int{3}},
+		{date: date(7, 10), tracked: 10 * time.Hour, ids: []int{2}},
+		{date: date(7, 29), tracked: 60 * time.Hour, ids: []int{1}},
+	}
+
+	expected := []violation{
+		{
+			rule:  ruleMaxWeekly,
+			first: date(7, 1),
+			last:  date(7, 14),
+			value: 50 * time.Hour,
+			limit: 48 * time.Hour,
+			ids:   []int{3, 2},
+		},
+	}
+
+	// The week of July 1st alone is 50h but averages 25h with the week
+	// before. The week of July 29th averages 30h with the empty week before.
+	assert.Equal(t, expected, checkMaxWeekly(days, 48*time.Hour, 2))
+}
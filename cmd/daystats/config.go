@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "daystats"
+	configKeyDayStart = "day_start"
+	defaultDayStart   = "00:00"
+	configKeyMaxSpan  = "max_span"
+	defaultMaxSpan    = "10h"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+var (
+	errInvalidDayStart = errors.New("invalid day start")
+	errNotPositive     = errors.New("duration must be positive")
+)
+
+type config struct {
+	// dayStart is the clock time days start at. Its date is ignored.
+	dayStart    time.Time
+	maxSpan     time.Duration
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseDayStart parses a time of day like "04:00" or "04:00:00".
+func parseDayStart(value twext.ConfigValue) (time.Time, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		t, err := time.Parse(layout, value.String())
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf(
+		"%w: %q is not a time of day", errInvalidDayStart, value,
+	)
+}
+
+func parsePositiveDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("%w: %s", errNotPositive, duration)
+	}
+
+	return duration, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	dayStart, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyDayStart),
+		defaultDayStart,
+		parseDayStart,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get day start: %w", err)
+	}
+
+	maxSpan, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMaxSpan),
+		defaultMaxSpan,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get max span: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		dayStart:    dayStart,
+		maxSpan:     maxSpan,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - DayStart:", cfg.dayStart.Format(time.TimeOnly))
+	log.Println("cfg - MaxSpan:", cfg.maxSpan)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
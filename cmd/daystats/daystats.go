@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	percent = 100
+	// medianDivisor is the divisor for the mean of the two middle values.
+	medianDivisor = 2
+	hoursPerDay   = 24
+)
+
+// day holds the first start, the last end and the tracked time of a day.
+type day struct {
+	// date is the midnight the day belongs to. With a day start other than
+	// midnight, the day starts later.
+	date    time.Time
+	start   time.Time
+	end     time.Time
+	tracked time.Duration
+}
+
+// span returns the time between the first start and the last end.
+func (d day) span() time.Duration {
+	return d.end.Sub(d.start)
+}
+
+// startClock returns the wall clock time of the first start as duration
+// since midnight of the date.
+func (d day) startClock() time.Duration {
+	return wallClock(d.start, d.date)
+}
+
+// endClock returns the wall clock time of the last end as duration since
+// midnight of the date. It exceeds 24 hours if the day ends after midnight.
+func (d day) endClock() time.Duration {
+	return wallClock(d.end, d.date)
+}
+
+// presence returns the share of the span that is tracked in percent.
+func (d day) presence() float64 {
+	return presence(d.tracked, d.span())
+}
+
+func presence(tracked, span time.Duration) float64 {
+	if span <= 0 {
+		return 0
+	}
+
+	return float64(tracked) / float64(span) * percent
+}
+
+// add extends the day by the given entry.
+func (d day) add(entry twext.Entry) day {
+	end := entry.CurrentEnd().Time
+
+	if d.start.IsZero() || entry.Start.Before(d.start) {
+		d.start = entry.Start.Time
+	}
+
+	if end.After(d.end) {
+		d.end = end
+	}
+
+	d.tracked += entry.Duration()
+
+	return d
+}
+
+// week holds the days of a single ISO week.
+type week struct {
+	name string
+	days []day
+}
+
+func (w week) clocks(fn func(day) time.Duration) []time.Duration {
+	clocks := make([]time.Duration, 0, len(w.days))
+	for _, d := range w.days {
+		clocks = append(clocks, fn(d))
+	}
+
+	return clocks
+}
+
+func (w week) averageStart() time.Duration {
+	return average(w.clocks(day.startClock))
+}
+
+func (w week) averageEnd() time.Duration {
+	return average(w.clocks(day.endClock))
+}
+
+func (w week) medianStart() time.Duration {
+	return median(w.clocks(day.startClock))
+}
+
+func (w week) medianEnd() time.Duration {
+	return median(w.clocks(day.endClock))
+}
+
+func (w week) averageSpan() time.Duration {
+	return average(w.clocks(day.span))
+}
+
+func (w week) averageTracked() time.Duration {
+	return average(w.clocks(func(d day) time.Duration { return d.tracked }))
+}
+
+// presence returns the share of the summed up spans that is tracked in
+// percent.
+func (w week) presence() float64 {
+	var tracked, span time.Duration
+
+	for _, d := range w.days {
+		tracked += d.tracked
+		span += d.span()
+	}
+
+	return presence(tracked, span)
+}
+
+func average(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	var sum time.Duration
+	for _, d := range durations {
+		sum += d
+	}
+
+	return sum / time.Duration(len(durations))
+}
+
+// median returns the middle value of the durations. For an even number of
+// durations, it is the mean of the two middle values.
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := slices.Sorted(slices.Values(durations))
+	middle := len(sorted) / medianDivisor
+
+	if len(sorted)%medianDivisor == 0 {
+		return (sorted[middle-1] + sorted[middle]) / medianDivisor
+	}
+
+	return sorted[middle]
+}
+
+func splitIntoDays(
+	entries twext.EntryIterator,
+	dayStart time.Time,
+) twext.EntryIterator {
+	return func(yield func(twext.Entry) bool) {
+		for entry := range entries {
+			for e := range twext.SplitIntoDays(entry, dayStart) {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// clockOffset returns the clock time of the given time as duration since
+// midnight.
+func clockOffset(t time.Time) time.Duration {
+	hour, minute, second := t.Clock()
+
+	return time.Duration(hour)*time.Hour +
+		time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second
+}
+
+// wallClock returns the wall clock time of the given time as duration since
+// midnight of the given date. It adds 24 hours for each date after the given
+// one, so clocks are not shifted by daylight saving time transitions.
+func wallClock(t time.Time, date time.Time) time.Duration {
+	year, month, mday := t.Date()
+	days := time.Date(year, month, mday, 0, 0, 0, 0, time.UTC).Sub(
+		time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC),
+	) / (hoursPerDay * time.Hour)
+
+	return days*hoursPerDay*time.Hour + clockOffset(t)
+}
+
+// dateOf returns the midnight of the date the given time belongs to. Times
+// before the day start on the wall clock belong to the previous date.
+func dateOf(t time.Time, dayStart time.Time) time.Time {
+	year, month, mday := t.Date()
+	date := time.Date(year, month, mday, 0, 0, 0, 0, t.Location())
+	start := time.Date(
+		year, month, mday,
+		dayStart.Hour(), dayStart.Minute(), dayStart.Second(), 0,
+		t.Location(),
+	)
+
+	if t.Before(start) {
+		return date.AddDate(0, 0, -1)
+	}
+
+	return date
+}
+
+// dateKey returns a function that returns the date an entry belongs to.
+func dateKey(dayStart time.Time) func(twext.Entry) string {
+	return func(entry twext.Entry) string {
+		return dateOf(entry.Start.Time, dayStart).Format(time.DateOnly)
+	}
+}
+
+func addEntry(d day, entry twext.Entry) day {
+	return d.add(entry)
+}
+
+// collectDays calculates the statistics per day and groups them by ISO week.
+// Entries are clipped to the report range and split at the configured day
+// start, so each part is accounted for its own day.
+func collectDays(entries twext.Entries, cfg config) ([]day, []week) {
+	perDay := twext.Aggregate(
+		splitIntoDays(
+			twext.InLocation(
+				twext.ClipEntries(entries.All(), cfg.reportRange),
+				cfg.location,
+			),
+			cfg.dayStart,
+		),
+		dateKey(cfg.dayStart),
+		addEntry,
+	)
+
+	var (
+		days  []day
+		weeks []week
+	)
+
+	for _, d := range perDay.Sorted() {
+		d.date = dateOf(d.start, cfg.dayStart)
+		days = append(days, d)
+
+		name := groupkey.ISOWeek(d.date)
+		if len(weeks) == 0 || weeks[len(weeks)-1].name != name {
+			weeks = append(weeks, week{name: name})
+		}
+
+		last := &weeks[len(weeks)-1]
+		last.days = append(last.days, d)
+	}
+
+	return days, weeks
+}
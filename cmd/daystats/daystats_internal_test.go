@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		expected  time.Duration
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "odd",
+			durations: []time.Duration{3 * time.Hour, time.Hour, 2 * time.Hour},
+			expected:  2 * time.Hour,
+		},
+		{
+			name: "even",
+			durations: []time.Duration{
+				4 * time.Hour, time.Hour, 2 * time.Hour, 10 * time.Hour,
+			},
+			expected: 3 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, median(tt.durations))
+		})
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	dayStart := time.Date(0, 1, 1, 4, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "before day start",
+			time:     time.Date(2024, 7, 2, 4, 29, 0, 0, time.UTC),
+			expected: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "at day start",
+			time:     time.Date(2024, 7, 2, 4, 30, 0, 0, time.UTC),
+			expected: time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "before midnight",
+			time:     time.Date(2024, 7, 2, 23, 59, 0, 0, time.UTC),
+			expected: time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, dateOf(tt.time, dayStart))
+		})
+	}
+}
+
+func TestDateOf_DST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	dayStart := time.Date(0, 1, 1, 4, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		{
+			name:     "23 hour day at day start",
+			time:     time.Date(2024, 3, 31, 4, 0, 0, 0, berlin),
+			expected: "2024-03-31",
+		},
+		{
+			name:     "23 hour day after day start",
+			time:     time.Date(2024, 3, 31, 4, 30, 0, 0, berlin),
+			expected: "2024-03-31",
+		},
+		{
+			name:     "23 hour day before day start",
+			time:     time.Date(2024, 3, 31, 3, 30, 0, 0, berlin),
+			expected: "2024-03-30",
+		},
+		{
+			name:     "25 hour day before day start",
+			time:     time.Date(2024, 10, 27, 3, 30, 0, 0, berlin),
+			expected: "2024-10-26",
+		},
+		{
+			name:     "25 hour day at day start",
+			time:     time.Date(2024, 10, 27, 4, 0, 0, 0, berlin),
+			expected: "2024-10-27",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := dateOf(tt.time, dayStart)
+			assert.Equal(t, tt.expected, actual.Format(time.DateOnly))
+			assert.Equal(t, berlin, actual.Location())
+		})
+	}
+}
+
+func TestDay_Clocks_DST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		date          time.Time
+		start         time.Time
+		end           time.Time
+		expectedStart time.Duration
+		expectedEnd   time.Duration
+	}{
+		{
+			name:          "23 hour day",
+			date:          time.Date(2024, 3, 31, 0, 0, 0, 0, berlin),
+			start:         time.Date(2024, 3, 31, 9, 0, 0, 0, berlin),
+			end:           time.Date(2024, 4, 1, 1, 0, 0, 0, berlin),
+			expectedStart: 9 * time.Hour,
+			expectedEnd:   25 * time.Hour,
+		},
+		{
+			name:          "25 hour day",
+			date:          time.Date(2024, 10, 27, 0, 0, 0, 0, berlin),
+			start:         time.Date(2024, 10, 27, 9, 0, 0, 0, berlin),
+			end:           time.Date(2024, 10, 27, 17, 30, 0, 0, berlin),
+			expectedStart: 9 * time.Hour,
+			expectedEnd:   17*time.Hour + 30*time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := day{date: tt.date, start: tt.start, end: tt.end}
+			assert.Equal(t, tt.expectedStart, d.startClock())
+			assert.Equal(t, tt.expectedEnd, d.endClock())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for daily start and end time statistics.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	days, weeks := collectDays(entries, cfg)
+	printDays(env.Out, days, weeks, cfg.maxSpan)
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "daystats",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
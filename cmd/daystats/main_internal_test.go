@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		EntryAt(at(7, 1, 8, 30), at(7, 1, 12, 0), "projA").
+		EntryAt(at(7, 1, 13, 0), at(7, 1, 17, 30), "projA").
+		EntryAt(at(7, 2, 9, 0), at(7, 2, 12, 30), "projB").
+		EntryAt(at(7, 2, 14, 0), at(7, 2, 20, 0), "projA").
+		EntryAt(at(7, 3, 7, 45), at(7, 3, 16, 15), "projA").
+		EntryAt(at(7, 4, 9, 0), at(7, 4, 13, 0), "projB").
+		EntryAt(at(7, 4, 22, 0), at(7, 5, 1, 30), "release").
+		EntryAt(at(7, 8, 8, 0), at(7, 8, 16, 0), "projA").
+		EntryAt(at(7, 9, 10, 0), at(7, 9, 11, 0), "projB").
+		EntryAt(at(7, 10, 9, 0), time.Time{}, "projA")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "invalid day start",
+			input:       newTestInput().Config("daystats.day_start", "25:00"),
+			expectedErr: errInvalidDayStart,
+		},
+		{
+			name:        "zero max span",
+			input:       newTestInput().Config("daystats.max_span", "0h"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "day start",
+			input: newTestInput().
+				Config("daystats.day_start", "04:00").
+				Config("daystats.max_span", "8h"),
+		},
+		{
+			name: "report range",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				Config("temp.report.start", "20240701T000000Z").
+				EntryAt(at(6, 30, 22, 0), at(7, 1, 1, 0), "release").
+				EntryAt(at(7, 1, 9, 0), at(7, 1, 17, 0), "projA"),
+		},
+		{
+			name:  "no entries",
+			input: twexttest.NewInput(testNow).Config("debug", "off"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const exceededMark = "!"
+
+func formatPercentage(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+func printDays(w io.Writer, days []day, weeks []week, maxSpan time.Duration) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(days) == 0 {
+		table.Line("No entries.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row("date", "start", "end", "span", "tracked", "presence", "")
+
+	var exceeded int
+
+	for _, d := range days {
+		var mark string
+		if d.span() > maxSpan {
+			mark = exceededMark
+			exceeded++
+		}
+
+		table.Row(
+			d.date.Format(time.DateOnly),
+			output.FormatHHMM(d.startClock()),
+			output.FormatHHMM(d.endClock()),
+			output.FormatDuration(d.span()),
+			output.FormatDuration(d.tracked),
+			formatPercentage(d.presence()),
+			mark,
+		)
+	}
+
+	table.Line("")
+	table.Row(
+		"week",
+		"days",
+		"avg start",
+		"avg end",
+		"median start",
+		"median end",
+		"avg span",
+		"avg tracked",
+		"presence",
+	)
+
+	all := week{name: "all", days: days}
+
+	for _, wk := range append(weeks, all) {
+		table.Row(
+			wk.name,
+			strconv.Itoa(len(wk.days)),
+			output.FormatHHMM(wk.averageStart()),
+			output.FormatHHMM(wk.averageEnd()),
+			output.FormatHHMM(wk.medianStart()),
+			output.FormatHHMM(wk.medianEnd()),
+			output.FormatDuration(wk.averageSpan()),
+			output.FormatDuration(wk.averageTracked()),
+			formatPercentage(wk.presence()),
+		)
+	}
+
+	if exceeded > 0 {
+		table.Line("")
+		table.Line(fmt.Sprintf(
+			"%s Span exceeds %s on %d of %d days.",
+			exceededMark,
+			output.FormatDuration(maxSpan),
+			exceeded,
+			len(days),
+		))
+	}
+
+	table.Flush()
+}
@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix             = "earnings"
+	configKeyRate               = "rate"
+	configKeyCurrencyFormat     = "currency_format"
+	defaultCurrencyFormat       = "{amount} EUR"
+	configKeyDecimalSeparator   = "decimal_separator"
+	defaultDecimalSeparator     = "."
+	configKeyThousandsSeparator = "thousands_separator"
+	defaultThousandsSeparator   = ""
+	configKeyTimezone           = "timezone"
+	defaultTimezone             = "local"
+	rateSeparator               = ","
+)
+
+var (
+	errNoRates               = errors.New("no rates configured")
+	errInvalidRate           = errors.New("invalid rate")
+	errNegative              = errors.New("must not be negative")
+	errInvalidCurrencyFormat = errors.New("missing amount placeholder")
+)
+
+// rateChange is an hourly rate that is effective from a given day on. A zero
+// day means the rate is effective from the beginning.
+type rateChange struct {
+	from   time.Time
+	amount decimal.Decimal
+}
+
+// rateSchedule holds the rate changes of a tag sorted by the day they take
+// effect.
+type rateSchedule []rateChange
+
+// at returns the rate effective on the given day. It returns false if no
+// rate is effective yet.
+func (s rateSchedule) at(day time.Time) (decimal.Decimal, bool) {
+	for _, change := range slices.Backward(s) {
+		if !change.from.After(day) {
+			return change.amount, true
+		}
+	}
+
+	return decimal.Decimal{}, false
+}
+
+func (s rateSchedule) String() string {
+	changes := make([]string, 0, len(s))
+
+	for _, change := range s {
+		if change.from.IsZero() {
+			changes = append(changes, change.amount.String())
+		} else {
+			changes = append(changes, change.from.Format(time.DateOnly)+
+				" "+change.amount.String())
+		}
+	}
+
+	return strings.Join(changes, rateSeparator+" ")
+}
+
+type config struct {
+	rates       map[string]rateSchedule
+	currency    currencyFormat
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseRateChange parses a rate change like "95" or, with the day it takes
+// effect, "2024-07-01 95".
+func parseRateChange(
+	definition string,
+	location *time.Location,
+) (rateChange, error) {
+	const (
+		withoutDate = 1
+		withDate    = 2
+	)
+
+	var change rateChange
+
+	fields := strings.Fields(definition)
+
+	switch len(fields) {
+	case withoutDate:
+	case withDate:
+		from, err := time.ParseInLocation(time.DateOnly, fields[0], location)
+		if err != nil {
+			return rateChange{}, fmt.Errorf("%w: %w", errInvalidRate, err)
+		}
+
+		change.from = from
+	default:
+		return rateChange{}, fmt.Errorf(
+			"%w: expected amount and optional date: %q",
+			errInvalidRate,
+			definition,
+		)
+	}
+
+	amount, err := decimal.Parse(fields[len(fields)-1])
+	if err != nil {
+		return rateChange{}, fmt.Errorf("parse amount: %w", err)
+	}
+
+	if amount.Cmp(decimal.Decimal{}) < 0 {
+		return rateChange{}, fmt.Errorf("amount %w: %s", errNegative, amount)
+	}
+
+	change.amount = amount
+
+	return change, nil
+}
+
+// parseRateSchedule parses a comma separated list of rate changes, like
+// "80, 2024-07-01 95".
+func parseRateSchedule(
+	value twext.ConfigValue,
+	location *time.Location,
+) (rateSchedule, error) {
+	var schedule rateSchedule
+
+	for definition := range strings.SplitSeq(value.String(), rateSeparator) {
+		change, err := parseRateChange(definition, location)
+		if err != nil {
+			return nil, err
+		}
+
+		schedule = append(schedule, change)
+	}
+
+	slices.SortFunc(schedule, func(a, b rateChange) int {
+		return a.from.Compare(b.from)
+	})
+
+	for idx := 1; idx < len(schedule); idx++ {
+		if schedule[idx].from.Equal(schedule[idx-1].from) {
+			return nil, fmt.Errorf(
+				"%w: multiple rates for the same day: %s",
+				errInvalidRate,
+				value,
+			)
+		}
+	}
+
+	return schedule, nil
+}
+
+// readRates reads the hourly rate schedules per tag. Since tags may contain
+// dots, everything after the rate key prefix is taken as tag.
+func readRates(
+	rawCfg twext.Config,
+	location *time.Location,
+) (map[string]rateSchedule, error) {
+	rates := make(map[string]rateSchedule)
+	prefix := configKey(configKeyRate)
+
+	for key, value := range rawCfg {
+		tag, match := key.SubKey(prefix)
+		if !match || tag == "" {
+			continue
+		}
+
+		schedule, err := parseRateSchedule(value, location)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		rates[tag.String()] = schedule
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: set %s.<tag>", errNoRates, prefix)
+	}
+
+	return rates, nil
+}
+
+func parseCurrencyFormat(value twext.ConfigValue) (string, error) {
+	if !strings.Contains(value.String(), amountPlaceholder) {
+		return "", fmt.Errorf("%w: %q", errInvalidCurrencyFormat, value)
+	}
+
+	return value.String(), nil
+}
+
+func parseString(value twext.ConfigValue) (string, error) {
+	return value.String(), nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	rates, err := readRates(rawCfg, location)
+	if err != nil {
+		return config{}, fmt.Errorf("get rates: %w", err)
+	}
+
+	template, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyCurrencyFormat),
+		defaultCurrencyFormat,
+		parseCurrencyFormat,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get currency format: %w", err)
+	}
+
+	decimalSeparator, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyDecimalSeparator),
+		defaultDecimalSeparator,
+		parseString,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get decimal separator: %w", err)
+	}
+
+	thousandsSeparator, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyThousandsSeparator),
+		defaultThousandsSeparator,
+		parseString,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get thousands separator: %w", err)
+	}
+
+	cfg := config{
+		rates: rates,
+		currency: currencyFormat{
+			template:           template,
+			decimalSeparator:   decimalSeparator,
+			thousandsSeparator: thousandsSeparator,
+		},
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	for _, tag := range slices.Sorted(maps.Keys(cfg.rates)) {
+		log.Printf("cfg - Rate: %s: %s", tag, cfg.rates[tag])
+	}
+
+	log.Println("cfg - CurrencyFormat:", cfg.currency.template)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseRateSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       twext.ConfigValue
+		expected    rateSchedule
+		expectedErr error
+	}{
+		{
+			name:  "single rate",
+			value: "95.50",
+			expected: rateSchedule{
+				{amount: decimal.MustParse("95.50")},
+			},
+		},
+		{
+			name:  "rate changes",
+			value: "2025-01-01 110, 80, 2024-07-01 95",
+			expected: rateSchedule{
+				{amount: decimal.MustParse("80")},
+				{from: date(7, 1), amount: decimal.MustParse("95")},
+				{
+					from:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					amount: decimal.MustParse("110"),
+				},
+			},
+		},
+		{
+			name:        "invalid date",
+			value:       "80, 2024-13-01 95",
+			expectedErr: errInvalidRate,
+		},
+		{
+			name:        "too many fields",
+			value:       "2024-07-01 95 EUR",
+			expectedErr: errInvalidRate,
+		},
+		{
+			name:        "duplicate date",
+			value:       "2024-07-01 95, 2024-07-01 100",
+			expectedErr: errInvalidRate,
+		},
+		{
+			name:        "empty rate",
+			value:       "80,",
+			expectedErr: errInvalidRate,
+		},
+		{
+			name:        "negative",
+			value:       "-80",
+			expectedErr: errNegative,
+		},
+		{
+			name:        "invalid amount",
+			value:       "80, 2024-07-01 9x",
+			expectedErr: decimal.ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := parseRateSchedule(tt.value, time.UTC)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestRateSchedule_At(t *testing.T) {
+	schedule := rateSchedule{
+		{from: date(7, 1), amount: decimal.MustParse("95")},
+		{from: date(8, 1), amount: decimal.MustParse("110")},
+	}
+
+	tests := []struct {
+		name              string
+		day               time.Time
+		expected          string
+		expectedEffective bool
+	}{
+		{
+			name:     "before first rate",
+			day:      date(6, 30),
+			expected: "0",
+		},
+		{
+			name:              "first day",
+			day:               date(7, 1),
+			expected:          "95",
+			expectedEffective: true,
+		},
+		{
+			name:              "later",
+			day:               date(8, 20),
+			expected:          "110",
+			expectedEffective: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, effective := schedule.at(tt.day)
+			assert.Equal(t, tt.expected, actual.String())
+			assert.Equal(t, tt.expectedEffective, effective)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+)
+
+const (
+	amountPlaceholder = "{amount}"
+	moneyPlaces       = 2
+	digitGroupSize    = 3
+)
+
+// currencyFormat formats money amounts. The template contains the amount
+// placeholder and the currency, like "{amount} EUR" or "${amount}".
+type currencyFormat struct {
+	template           string
+	decimalSeparator   string
+	thousandsSeparator string
+}
+
+// groupDigits inserts the separator between groups of three digits of the
+// integer part.
+func groupDigits(digits, separator string) string {
+	if separator == "" || len(digits) <= digitGroupSize {
+		return digits
+	}
+
+	var grouped strings.Builder
+
+	first := len(digits) % digitGroupSize
+	if first == 0 {
+		first = digitGroupSize
+	}
+
+	grouped.WriteString(digits[:first])
+
+	for idx := first; idx < len(digits); idx += digitGroupSize {
+		grouped.WriteString(separator)
+		grouped.WriteString(digits[idx : idx+digitGroupSize])
+	}
+
+	return grouped.String()
+}
+
+// format formats the amount rounded to cents.
+func (f currencyFormat) format(amount decimal.Decimal) string {
+	var sign string
+
+	formatted, negative := strings.CutPrefix(amount.Format(moneyPlaces), "-")
+	if negative {
+		sign = "-"
+	}
+
+	integer, fraction, _ := strings.Cut(formatted, ".")
+	number := sign + groupDigits(integer, f.thousandsSeparator) +
+		f.decimalSeparator + fraction
+
+	return strings.ReplaceAll(f.template, amountPlaceholder, number)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   currencyFormat
+		amount   string
+		expected string
+	}{
+		{
+			name: "default",
+			format: currencyFormat{
+				template:         "{amount} EUR",
+				decimalSeparator: ".",
+			},
+			amount:   "1234567.891",
+			expected: "1234567.89 EUR",
+		},
+		{
+			name: "grouped",
+			format: currencyFormat{
+				template:           "€ {amount}",
+				decimalSeparator:   ",",
+				thousandsSeparator: ".",
+			},
+			amount:   "1234567.895",
+			expected: "€ 1.234.567,90",
+		},
+		{
+			name: "small",
+			format: currencyFormat{
+				template:           "${amount}",
+				decimalSeparator:   ".",
+				thousandsSeparator: ",",
+			},
+			amount:   "999",
+			expected: "$999.00",
+		},
+		{
+			name: "negative",
+			format: currencyFormat{
+				template:           "${amount}",
+				decimalSeparator:   ".",
+				thousandsSeparator: ",",
+			},
+			amount:   "-123456",
+			expected: "$-123,456.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := tt.format.format(decimal.MustParse(tt.amount))
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const monthFormat = "2006-01"
+
+// amount is the tracked time and the earnings of a group.
+type amount struct {
+	duration time.Duration
+	earnings decimal.Decimal
+}
+
+func (a amount) add(duration time.Duration, earnings decimal.Decimal) amount {
+	return amount{
+		duration: a.duration + duration,
+		earnings: a.earnings.Add(earnings),
+	}
+}
+
+// hourlyRate returns the earnings per hour. It is 0 if no time is tracked.
+func (a amount) hourlyRate() decimal.Decimal {
+	if a.duration == 0 {
+		return decimal.Decimal{}
+	}
+
+	return a.earnings.Div(decimal.Hours(a.duration))
+}
+
+// breakdown holds the amounts per group, like per day or per tag.
+type breakdown struct {
+	name    string
+	amounts map[string]amount
+}
+
+func newBreakdown(name string) breakdown {
+	return breakdown{name: name, amounts: make(map[string]amount)}
+}
+
+func (b breakdown) add(
+	group string,
+	duration time.Duration,
+	earnings decimal.Decimal,
+) {
+	b.amounts[group] = b.amounts[group].add(duration, earnings)
+}
+
+// groups returns the groups in ascending order.
+func (b breakdown) groups() []string {
+	return slices.Sorted(maps.Keys(b.amounts))
+}
+
+// summary holds the earnings broken down by tag, month, week and day. The
+// total includes the time without rate, so its hourly rate is the effective
+// rate across all tracked time.
+type summary struct {
+	breakdowns []breakdown
+	total      amount
+	billable   time.Duration
+}
+
+// summarize sums up the earnings per tag, month, week and day. Only the time
+// within the report range is taken into account. Entries are split at
+// midnight, so each part is paid with the rate effective on its day. The
+// earnings of each part are rounded to cents before they are summed up.
+func summarize(entries twext.Entries, cfg config) (summary, error) {
+	perTag := newBreakdown("tag")
+	perMonth := newBreakdown("month")
+	perWeek := newBreakdown("week")
+	perDay := newBreakdown("date")
+
+	result := summary{
+		breakdowns: []breakdown{perTag, perMonth, perWeek, perDay},
+	}
+
+	for entry := range twext.SplitAtMidnight(
+		twext.InLocation(
+			twext.ClipEntries(entries.All(), cfg.reportRange),
+			cfg.location,
+		),
+	) {
+		duration := entry.Duration()
+		result.total.duration += duration
+
+		tag, err := groupkey.UniqueTag(entry, cfg.rates)
+		if err != nil {
+			return summary{}, fmt.Errorf("get rate: %w", err)
+		}
+
+		if tag == "" {
+			log.Printf("entry %d has no tag with rate. Skipping.", entry.ID)
+
+			continue
+		}
+
+		rate, effective := cfg.rates[tag].at(entry.Start.Time)
+		if !effective {
+			log.Printf("entry %d has no effective rate. Skipping.", entry.ID)
+
+			continue
+		}
+
+		earnings := rate.Mul(decimal.Hours(duration)).Round(moneyPlaces)
+		result.total.earnings = result.total.earnings.Add(earnings)
+		result.billable += duration
+
+		perTag.add(tag, duration, earnings)
+		perMonth.add(entry.Start.Format(monthFormat), duration, earnings)
+		perWeek.add(groupkey.ISOWeek(entry.Start.Time), duration, earnings)
+		perDay.add(entry.Start.Format(time.DateOnly), duration, earnings)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for summarizing earnings.
+package main
+
+import (
+	"fmt"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	s, err := summarize(entries, cfg)
+	if err != nil {
+		return fmt.Errorf("summarize: %w", err)
+	}
+
+	printSummary(env.Out, s, cfg.currency)
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "earnings",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("earnings.rate.projA", "80, 2024-07-01 95").
+		Config("earnings.rate.proj.B", "1200").
+		EntryAt(at(6, 27, 9, 0), at(6, 27, 17, 0), "projA").
+		EntryAt(at(6, 30, 22, 0), at(7, 1, 2, 0), "projA", "release").
+		EntryAt(at(7, 2, 9, 0), at(7, 2, 12, 20), "proj.B").
+		EntryAt(at(7, 3, 9, 0), at(7, 3, 12, 0), "meeting").
+		EntryAt(at(7, 8, 9, 0), at(7, 8, 17, 0), "projA").
+		EntryAt(at(7, 10, 9, 0), time.Time{}, "proj.B")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "no rates",
+			input:       twexttest.NewInput(testNow).Config("debug", "off"),
+			expectedErr: errNoRates,
+		},
+		{
+			name: "invalid rate",
+			input: newTestInput().
+				Config("earnings.rate.projC", "2024-07-01 95 EUR"),
+			expectedErr: errInvalidRate,
+		},
+		{
+			name: "invalid currency format",
+			input: newTestInput().
+				Config("earnings.currency_format", "EUR"),
+			expectedErr: errInvalidCurrencyFormat,
+		},
+		{
+			name: "ambiguous tag",
+			input: newTestInput().
+				EntryAt(at(7, 9, 9, 0), at(7, 9, 10, 0), "projA", "proj.B"),
+			expectedErr: groupkey.ErrAmbiguousTag,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "currency format",
+			input: newTestInput().
+				Config("earnings.currency_format", "€ {amount}").
+				Config("earnings.decimal_separator", ",").
+				Config("earnings.thousands_separator", "."),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config("temp.report.end", "20240701T000000Z"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
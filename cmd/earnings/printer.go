@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+func printSummary(w io.Writer, s summary, currency currencyFormat) {
+	table := output.NewTable(w)
+
+	for _, b := range s.breakdowns {
+		table.Line("")
+		table.Row(b.name, "hours", "earnings", "rate")
+
+		for _, group := range b.groups() {
+			a := b.amounts[group]
+			table.Row(
+				group,
+				output.FormatHours(a.duration),
+				currency.format(a.earnings),
+				currency.format(a.hourlyRate()),
+			)
+		}
+	}
+
+	table.Line("")
+	table.Row("tracked", output.FormatHours(s.total.duration))
+	table.Row("billable", output.FormatHours(s.billable))
+	table.Row("earnings", currency.format(s.total.earnings))
+	table.Row("effective rate", currency.format(s.total.hourlyRate()))
+	table.Flush()
+}
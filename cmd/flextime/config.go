@@ -6,75 +6,32 @@ package main
 
 import (
 	"fmt"
-	"strings"
+	"log"
 	"time"
 
+	"github.com/aibor/timewarrior-extensions/internal/timetarget"
 	"github.com/aibor/timewarrior-extensions/twext"
 )
 
-const numberOfWeekdays = 7
-
 const (
-	configKeyPrefix              = "flextime"
-	configKeyTimeTarget          = "time_per_day"
-	configSubKeyDateOverride     = "date"
-	defaultTimeTarget            = "8h"
+	configKeyPrefix              = timetarget.ConfigKeyPrefix
 	configKeyOffsetTotal         = "offset_total"
 	defaultOffsetTotal           = "0"
 	configKeyAggregationStrategy = "aggregation_strategy"
 	defaultAggregationStrategy   = "single-day-only"
+	configKeyTimezone            = "timezone"
+	defaultTimezone              = "local"
 )
 
-type timeTargets struct {
-	dates           map[time.Time]time.Duration
-	weekdays        map[time.Weekday]time.Duration
-	defaultDuration time.Duration
-}
-
-func (t timeTargets) String() string {
-	str := &strings.Builder{}
-	_, _ = fmt.Fprintf(str, "Default: %s", t.defaultDuration)
-
-	for day, duration := range t.weekdays {
-		_, _ = fmt.Fprintf(str, " %s: %s", day, duration)
-	}
-
-	return str.String()
-}
-
-func (t timeTargets) targetFor(day time.Time) time.Duration {
-	cleanDay := time.Date(
-		day.Year(), day.Month(), day.Day(),
-		0, 0, 0, 0,
-		time.UTC,
-	)
-
-	if override, exists := t.dates[cleanDay]; exists {
-		return override
-	}
-
-	if target, exists := t.weekdays[cleanDay.Weekday()]; exists {
-		return target
-	}
-
-	return t.defaultDuration
-}
-
 type config struct {
-	timeTargets         timeTargets
+	timeTargets         timetarget.Targets
 	offset              time.Duration
-	aggregationStrategy *aggregationStrategy[string, time.Duration]
-	debug               bool
-	verbose             bool
+	aggregationStrategy *timetarget.AggregationStrategy[string, time.Duration]
+	location            *time.Location
 }
 
-func readConfig(reader *twext.Reader) (config, error) {
-	rawCfg, err := reader.ReadConfig()
-	if err != nil {
-		return config{}, fmt.Errorf("read config section: %w", err)
-	}
-
-	target, err := readTimeTargetConfig(rawCfg)
+func parseConfig(rawCfg twext.Config) (config, error) {
+	target, err := timetarget.Read(rawCfg)
 	if err != nil {
 		return config{}, fmt.Errorf("get time target: %w", err)
 	}
@@ -99,73 +56,29 @@ func readConfig(reader *twext.Reader) (config, error) {
 		return config{}, fmt.Errorf("get aggregation strategy: %w", err)
 	}
 
-	cfg := config{
-		timeTargets:         target,
-		offset:              offset,
-		aggregationStrategy: strategy,
-		debug:               rawCfg[twext.ConfigKeyDebug].Bool(),
-		verbose:             rawCfg[twext.ConfigKeyVerbose].Bool(),
-	}
-
-	return cfg, nil
-}
-
-func readTimeTargetConfig(twConfig twext.Config) (timeTargets, error) {
-	var err error
-
-	targets := timeTargets{
-		dates:    make(map[time.Time]time.Duration),
-		weekdays: make(map[time.Weekday]time.Duration, numberOfWeekdays),
-	}
-
-	targets.defaultDuration, err = configRead(
-		twConfig,
-		configKeyTimeTarget,
-		defaultTimeTarget,
-		parseDuration,
+	location, err := configRead(
+		rawCfg,
+		configKeyTimezone,
+		defaultTimezone,
+		parseLocation,
 	)
 	if err != nil {
-		return timeTargets{}, fmt.Errorf("get default target: %w", err)
+		return config{}, fmt.Errorf("get timezone: %w", err)
 	}
 
-	for day := range time.Weekday(numberOfWeekdays) {
-		subKey := strings.ToLower(day.String())
-		key := twext.NewConfigKey(configKeyPrefix, configKeyTimeTarget, subKey)
-		cfgValue, exists := twConfig[key]
-
-		if !exists {
-			continue
-		}
-
-		targets.weekdays[day], err = parseDuration(cfgValue)
-		if err != nil {
-			return timeTargets{}, fmt.Errorf("get target for %s: %w", day, err)
-		}
+	cfg := config{
+		timeTargets:         target,
+		offset:              offset,
+		aggregationStrategy: strategy,
+		location:            location,
 	}
 
-	overrideKey := twext.NewConfigKey(
-		configKeyPrefix,
-		configKeyTimeTarget,
-		configSubKeyDateOverride,
-	)
-	for key, override := range twConfig {
-		subKey, match := key.SubKey(overrideKey)
-		if !match {
-			continue
-		}
-
-		date, err := time.Parse(time.DateOnly, subKey.String())
-		if err != nil {
-			return timeTargets{}, fmt.Errorf("get date for override: %w", err)
-		}
-
-		targets.dates[date], err = parseDuration(override)
-		if err != nil {
-			return timeTargets{}, fmt.Errorf("get target for %s: %w", date, err)
-		}
-	}
+	log.Println("cfg - Offset:", cfg.offset)
+	log.Println("cfg - Target:", cfg.timeTargets)
+	log.Println("cfg - AggregationStrategy:", cfg.aggregationStrategy)
+	log.Println("cfg - Timezone:", cfg.location)
 
-	return targets, nil
+	return cfg, nil
 }
 
 //nolint:ireturn,nolintlint
@@ -177,12 +90,7 @@ func configRead[R any](
 ) (R, error) {
 	key := twext.NewConfigKey(configKeyPrefix, cfgKey)
 
-	cfgValue, exists := twConfig[key]
-	if !exists {
-		cfgValue = defValue
-	}
-
-	return parseValue(cfgValue)
+	return twext.ReadConfigValue(twConfig, key, defValue, parseValue)
 }
 
 func parseDuration(value twext.ConfigValue) (time.Duration, error) {
@@ -196,11 +104,20 @@ func parseDuration(value twext.ConfigValue) (time.Duration, error) {
 
 func parseAggregationStrategy(
 	value twext.ConfigValue,
-) (*aggregationStrategy[string, time.Duration], error) {
-	strategy, err := createAggregationStrategy(value.String())
+) (*timetarget.AggregationStrategy[string, time.Duration], error) {
+	strategy, err := timetarget.NewAggregationStrategy(value.String())
 	if err != nil {
 		return nil, fmt.Errorf("create aggregation strategy: %w", err)
 	}
 
 	return strategy, nil
 }
+
+func parseLocation(value twext.ConfigValue) (*time.Location, error) {
+	location, err := value.Location()
+	if err != nil {
+		return nil, fmt.Errorf("convert to location: %w", err)
+	}
+
+	return location, nil
+}
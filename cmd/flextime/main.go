@@ -8,10 +8,6 @@ package main
 
 import (
 	"fmt"
-	"io"
-	"log"
-	"os"
-	"runtime/debug"
 	"time"
 
 	"github.com/aibor/timewarrior-extensions/twext"
@@ -19,24 +15,14 @@ import (
 
 type daySums = twext.Aggregation[string, time.Duration]
 
-func printSums(p *printer, daySums daySums) (err error) {
-	defer func() {
-		if rec := recover(); rec != nil {
-			var ok bool
-			if err, ok = rec.(error); !ok {
-				//nolint:err113
-				err = fmt.Errorf("non-error panic: %v", rec)
-			}
-		}
-	}()
-
+func printSums(p *printer, daySums daySums, verbose bool) error {
 	p.writeHeader()
 
 	var totalTarget time.Duration
 
 	totalSum := p.cfg.offset
 
-	if p.cfg.offset != 0 && p.cfg.verbose {
+	if p.cfg.offset != 0 && verbose {
 		p.writeTime("offset", p.cfg.offset, 0)
 	}
 
@@ -46,12 +32,12 @@ func printSums(p *printer, daySums daySums) (err error) {
 			return fmt.Errorf("parse date: %w", err)
 		}
 
-		dayTarget := p.cfg.timeTargets.targetFor(date)
+		dayTarget := p.cfg.timeTargets.For(date)
 
 		totalSum += daySum
 		totalTarget += dayTarget
 
-		if !p.cfg.verbose {
+		if !verbose {
 			continue
 		}
 
@@ -61,50 +47,15 @@ func printSums(p *printer, daySums daySums) (err error) {
 	p.writeTotals(totalSum, totalTarget)
 	p.flush()
 
-	return err
-}
-
-func version() string {
-	buildInfo, ok := debug.ReadBuildInfo()
-	if !ok {
-		return ""
-	}
-
-	return buildInfo.Main.Version
+	return nil
 }
 
-func run(inR io.ReadSeeker, outW, errW io.Writer) error {
-	reader := twext.NewReader(inR)
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	printer := newPrinter(env.Out, cfg)
+	localEntries := twext.InLocation(entries.All(), cfg.location)
+	daySums := cfg.aggregationStrategy.Aggregate(localEntries)
 
-	cfg, err := readConfig(reader)
-	if err != nil {
-		return fmt.Errorf("read config: %w", err)
-	}
-
-	log.SetPrefix("debug [flextime] - ")
-	log.SetFlags(0)
-
-	if cfg.debug {
-		log.SetOutput(errW)
-		log.Println("version:", version())
-		log.Println("cfg - Offset:", cfg.offset)
-		log.Println("cfg - Target:", cfg.timeTargets)
-		log.Println("cfg - AggregationStrategy:", cfg.aggregationStrategy)
-		log.Println("cfg - Debug:", cfg.debug)
-		log.Println("cfg - Verbose:", cfg.verbose)
-	} else {
-		log.SetOutput(io.Discard)
-	}
-
-	entries, err := reader.ReadEntries()
-	if err != nil {
-		return fmt.Errorf("read entries: %w", err)
-	}
-
-	printer := newPrinter(outW, cfg)
-	daySums := cfg.aggregationStrategy.Aggregate(entries.All())
-
-	err = printSums(printer, daySums)
+	err := printSums(printer, daySums, env.Verbose)
 	if err != nil {
 		return fmt.Errorf("print day sums: %w", err)
 	}
@@ -112,10 +63,14 @@ func run(inR io.ReadSeeker, outW, errW io.Writer) error {
 	return nil
 }
 
-func main() {
-	err := run(os.Stdin, os.Stdout, os.Stderr)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "flextime",
+		ParseConfig: parseConfig,
+		Render:      render,
 	}
 }
+
+func main() {
+	newReport().Main()
+}
@@ -5,14 +5,20 @@
 package main
 
 import (
-	"strings"
 	"testing"
+	"time"
 
+	"github.com/aibor/timewarrior-extensions/internal/timetarget"
 	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
 func TestRun(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -28,7 +34,7 @@ func TestRun(t *testing.T) {
 		{
 			name:        "invalid config aggregation strategy",
 			input:       "flextime.aggregation_strategy: broken",
-			expectedErr: errUnknownAggregationStrategy,
+			expectedErr: timetarget.ErrUnknownAggregationStrategy,
 		},
 		{
 			name: "quiet",
@@ -228,6 +234,27 @@ flextime.time_per_day.date.2025-02-28: 9h
     2025-02-21     3h:00m     4h:00m    -0h:59m
     2025-02-28     7h:05m     9h:00m    -1h:54m
          total    19h:29m    21h:00m    -1h:30m
+`,
+		},
+		{
+			name: "timezone",
+			input: `verbose: on
+flextime.timezone: Europe/Berlin
+flextime.aggregation_strategy: split-at-midnight
+
+[
+{"id":3,"start":"20240629T223000Z","end":"20240629T233000Z"},
+{"id":2,"start":"20240630T210000Z","end":"20240630T230000Z"},
+{"id":1,"start":"20241026T200000Z","end":"20241028T010000Z"}
+]`,
+			expectedStdout: `
+          date     actual     target       diff
+    2024-06-30     2h:00m     8h:00m    -6h:00m
+    2024-07-01     1h:00m     8h:00m    -7h:00m
+    2024-10-26     2h:00m     8h:00m    -6h:00m
+    2024-10-27    25h:00m     8h:00m    17h:00m
+    2024-10-28     2h:00m     8h:00m    -6h:00m
+         total    32h:00m    40h:00m    -8h:00m
 `,
 		},
 		{
@@ -243,11 +270,12 @@ flextime.time_per_day.wednesday: 4h
     total    0h:00m    0h:00m    0h:00m
 `,
 			expectedStderr: `debug [flextime] - version: (devel)
+debug [flextime] - cfg - Debug: true
+debug [flextime] - cfg - Verbose: false
 debug [flextime] - cfg - Offset: 0s
 debug [flextime] - cfg - Target: Default: 8h0m0s Wednesday: 4h0m0s
 debug [flextime] - cfg - AggregationStrategy: single-day-only
-debug [flextime] - cfg - Debug: true
-debug [flextime] - cfg - Verbose: false
+debug [flextime] - cfg - Timezone: UTC
 debug [flextime] - entry 3 spans multiple days. Skipping.
 `,
 		},
@@ -255,19 +283,37 @@ debug [flextime] - entry 3 spans multiple days. Skipping.
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var stdout, stderr strings.Builder
-
-			stdin := strings.NewReader(tt.input)
-
-			err := run(stdin, &stdout, &stderr)
-			require.ErrorIs(t, err, tt.expectedErr)
+			result := twexttest.Run(newReport().Run, tt.input)
+			require.ErrorIs(t, result.Err, tt.expectedErr)
 
 			if tt.expectedErr != nil {
 				return
 			}
 
-			assert.Equal(t, tt.expectedStdout, stdout.String(), "stdout")
-			assert.Equal(t, tt.expectedStderr, stderr.String(), "stderr")
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Equal(t, tt.expectedStderr, result.Stderr, "stderr")
 		})
 	}
 }
+
+func TestRun_ActiveEntry(t *testing.T) {
+	now := time.Date(2025, 2, 21, 15, 30, 0, 0, time.UTC)
+	twexttest.FixedClock(t, now)
+
+	input := twexttest.NewInput(now).
+		Config(twext.ConfigKeyVerbose, "on").
+		Entry(-30*time.Hour, -25*time.Hour).
+		Entry(-7*time.Hour, -4*time.Hour).
+		OpenEntry(-3 * time.Hour)
+
+	result := twexttest.Run(newReport().Run, input.String())
+	require.NoError(t, result.Err)
+
+	expected := `
+          date     actual     target       diff
+    2025-02-20     5h:00m     8h:00m    -3h:00m
+    2025-02-21     6h:00m     8h:00m    -2h:00m
+         total    11h:00m    16h:00m    -5h:00m
+`
+	assert.Equal(t, expected, result.Stdout)
+}
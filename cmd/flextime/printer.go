@@ -9,24 +9,9 @@ import (
 	"io"
 	"text/tabwriter"
 	"time"
-)
-
-const minutesPerHour = 60
-
-func fmtDuration(d time.Duration) string {
-	var prefix string
-
-	if d < 0 {
-		prefix = "-"
-	}
 
-	return fmt.Sprintf(
-		"%s%dh:%02dm",
-		prefix,
-		int64(d.Abs().Hours()),
-		int64(d.Abs().Minutes())%minutesPerHour,
-	)
-}
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
 
 const (
 	tabPadding = 4
@@ -66,9 +51,9 @@ func (p *printer) write(handle string, actual, target, diff string) {
 func (p *printer) writeTime(handle string, actual, target time.Duration) {
 	p.write(
 		handle,
-		fmtDuration(actual),
-		fmtDuration(target),
-		fmtDuration(actual-target),
+		output.FormatDuration(actual),
+		output.FormatDuration(target),
+		output.FormatDuration(actual-target),
 	)
 }
 
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix    = "focus"
+	configKeyThreshold = "threshold"
+	defaultThreshold   = "90m"
+	configKeyMaxBreak  = "max_break"
+	defaultMaxBreak    = "5m"
+	configKeyTimezone  = "timezone"
+	defaultTimezone    = "local"
+)
+
+var (
+	errNotPositive = errors.New("duration must be positive")
+	errNegative    = errors.New("duration must not be negative")
+)
+
+type config struct {
+	threshold   time.Duration
+	maxBreak    time.Duration
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parsePositiveDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("%w: %s", errNotPositive, duration)
+	}
+
+	return duration, nil
+}
+
+func parseNonNegativeDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegative, duration)
+	}
+
+	return duration, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	threshold, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyThreshold),
+		defaultThreshold,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get threshold: %w", err)
+	}
+
+	maxBreak, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMaxBreak),
+		defaultMaxBreak,
+		parseNonNegativeDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get max break: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		threshold:   threshold,
+		maxBreak:    maxBreak,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Threshold:", cfg.threshold)
+	log.Println("cfg - MaxBreak:", cfg.maxBreak)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
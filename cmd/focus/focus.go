@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// block is an uninterrupted time span on the same tags. It is made of
+// consecutive entries with identical tags and short breaks between them.
+type block struct {
+	tags     string
+	end      time.Time
+	duration time.Duration
+}
+
+// stats are the focus metrics of a period like a day or a week.
+type stats struct {
+	days     int
+	tracked  time.Duration
+	blocks   int
+	switches int
+	longest  time.Duration
+	deep     time.Duration
+}
+
+// add adds the metrics of another period.
+func (s stats) add(o stats) stats {
+	return stats{
+		days:     s.days + o.days,
+		tracked:  s.tracked + o.tracked,
+		blocks:   s.blocks + o.blocks,
+		switches: s.switches + o.switches,
+		longest:  max(s.longest, o.longest),
+		deep:     s.deep + o.deep,
+	}
+}
+
+// averageSession returns the average duration of the blocks.
+func (s stats) averageSession() time.Duration {
+	if s.blocks == 0 {
+		return 0
+	}
+
+	return s.tracked / time.Duration(s.blocks)
+}
+
+// switchesPerDay returns the average number of tag switches per day.
+func (s stats) switchesPerDay() float64 {
+	if s.days == 0 {
+		return 0
+	}
+
+	return float64(s.switches) / float64(s.days)
+}
+
+// deepShare returns the share of the tracked time spent in blocks at least
+// as long as the threshold in percent.
+func (s stats) deepShare() float64 {
+	if s.tracked == 0 {
+		return 0
+	}
+
+	const percent = 100
+
+	return float64(s.deep) / float64(s.tracked) * percent
+}
+
+// period holds the metrics of a named period, like a date or a week.
+type period struct {
+	name string
+	stats
+}
+
+func tagsKey(entry twext.Entry) string {
+	tags := slices.Compact(slices.Sorted(slices.Values(entry.Tags)))
+
+	return strings.Join(tags, " ")
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func appendEntry(entries twext.Entries, entry twext.Entry) twext.Entries {
+	return append(entries, entry)
+}
+
+func compareStart(a, b twext.Entry) int {
+	return a.Start.Compare(b.Start.Time)
+}
+
+// blocks joins the entries of a day into blocks. Consecutive entries with
+// identical tags are joined, if the break between them is not longer than
+// the maximum break.
+func blocks(entries twext.Entries, maxBreak time.Duration) []block {
+	var result []block
+
+	for _, entry := range slices.SortedStableFunc(entries.All(), compareStart) {
+		tags := tagsKey(entry)
+
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if last.tags == tags && entry.Start.Sub(last.end) <= maxBreak {
+				if end := entry.CurrentEnd().Time; end.After(last.end) {
+					last.end = end
+				}
+
+				last.duration += entry.Duration()
+
+				continue
+			}
+		}
+
+		result = append(result, block{
+			tags:     tags,
+			end:      entry.CurrentEnd().Time,
+			duration: entry.Duration(),
+		})
+	}
+
+	return result
+}
+
+// dayStats calculates the metrics of the blocks of a single day.
+func dayStats(blocks []block, threshold time.Duration) stats {
+	s := stats{days: 1, blocks: len(blocks)}
+
+	for idx, b := range blocks {
+		s.tracked += b.duration
+		s.longest = max(s.longest, b.duration)
+
+		if b.duration >= threshold {
+			s.deep += b.duration
+		}
+
+		if idx > 0 && blocks[idx-1].tags != b.tags {
+			s.switches++
+		}
+	}
+
+	return s
+}
+
+// analyze calculates the metrics per day and per ISO week. Entries are
+// clipped to the report range and split at midnight, so each part is
+// accounted for its own day.
+func analyze(entries twext.Entries, cfg config) ([]period, []period) {
+	perDay := twext.Aggregate(
+		twext.SplitAtMidnight(twext.InLocation(
+			twext.ClipEntries(entries.All(), cfg.reportRange),
+			cfg.location,
+		)),
+		startDate,
+		appendEntry,
+	)
+
+	var days, weeks []period
+
+	for date, dayEntries := range perDay.Sorted() {
+		day := period{
+			name:  date,
+			stats: dayStats(blocks(dayEntries, cfg.maxBreak), cfg.threshold),
+		}
+		days = append(days, day)
+
+		week := groupkey.ISOWeek(dayEntries[0].Start.Time)
+		if len(weeks) == 0 || weeks[len(weeks)-1].name != week {
+			weeks = append(weeks, period{name: week})
+		}
+
+		last := &weeks[len(weeks)-1]
+		last.stats = last.stats.add(day.stats)
+	}
+
+	return days, weeks
+}
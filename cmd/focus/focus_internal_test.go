@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func entry(start, end string, tags ...string) twext.Entry {
+	parse := func(clock string) twext.Time {
+		t, err := time.Parse(time.TimeOnly, clock+":00")
+		if err != nil {
+			panic(err)
+		}
+
+		return twext.Time{Time: t}
+	}
+
+	return twext.Entry{Start: parse(start), End: parse(end), Tags: tags}
+}
+
+func TestBlocks(t *testing.T) {
+	entries := twext.Entries{
+		entry("13:00", "14:00", "b"),
+		entry("09:00", "10:00", "a"),
+		entry("10:05", "11:00", "a"),
+		entry("11:00", "12:00", "b", "a"),
+		entry("12:00", "12:30", "a", "b"),
+	}
+
+	tests := []struct {
+		name     string
+		maxBreak time.Duration
+		expected []time.Duration
+	}{
+		{
+			name: "no break",
+			expected: []time.Duration{
+				time.Hour, 55 * time.Minute, 90 * time.Minute, time.Hour,
+			},
+		},
+		{
+			name:     "short break",
+			maxBreak: 5 * time.Minute,
+			expected: []time.Duration{
+				115 * time.Minute, 90 * time.Minute, time.Hour,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []time.Duration
+
+			for _, b := range blocks(entries, tt.maxBreak) {
+				actual = append(actual, b.duration)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestDayStats(t *testing.T) {
+	blocks := []block{
+		{tags: "a", duration: 2 * time.Hour},
+		{tags: "b", duration: 30 * time.Minute},
+		{tags: "b", duration: 30 * time.Minute},
+		{tags: "a", duration: time.Hour},
+	}
+
+	expected := stats{
+		days:     1,
+		tracked:  4 * time.Hour,
+		blocks:   4,
+		switches: 2,
+		longest:  2 * time.Hour,
+		deep:     2 * time.Hour,
+	}
+
+	actual := dayStats(blocks, 90*time.Minute)
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, time.Hour, actual.averageSession())
+	assert.InDelta(t, 50.0, actual.deepShare(), 0.001)
+	assert.InDelta(t, 2.0, actual.switchesPerDay(), 0.001)
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 9, 0), at(1, 10, 0), "projA").
+		EntryAt(at(1, 10, 3), at(1, 11, 0), "projA").
+		EntryAt(at(1, 11, 0), at(1, 11, 30), "projB").
+		EntryAt(at(1, 11, 30), at(1, 12, 0), "projA").
+		EntryAt(at(1, 13, 0), at(1, 14, 0), "projA").
+		EntryAt(at(3, 9, 0), at(3, 12, 0), "projA").
+		EntryAt(at(3, 12, 0), at(3, 12, 30), "projB").
+		EntryAt(at(8, 9, 0), at(8, 10, 0), "projA").
+		EntryAt(at(8, 10, 0), at(8, 11, 0), "projB").
+		EntryAt(at(8, 11, 0), at(8, 12, 0))
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "zero threshold",
+			input:       newTestInput().Config("focus.threshold", "0s"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name:        "negative max break",
+			input:       newTestInput().Config("focus.max_break", "-1m"),
+			expectedErr: errNegative,
+		},
+		{
+			name:  "no entries",
+			input: twexttest.NewInput(testNow).Config("debug", "off"),
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "threshold and max break",
+			input: newTestInput().
+				Config("focus.threshold", "1h").
+				Config("focus.max_break", "1h"),
+		},
+		{
+			name: "report range",
+			input: twexttest.NewInput(testNow).
+				Config(twext.ConfigKeyVerbose, "on").
+				Config(twext.ConfigKeyReportStart, "20240701T000000Z").
+				EntryAt(at(0, 23, 0), at(1, 1, 0), "projC").
+				EntryAt(at(1, 9, 0), at(1, 10, 0), "projA"),
+		},
+		{
+			name: "timezone",
+			input: newTestInput().
+				Config("focus.timezone", "Pacific/Auckland"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 1, 64)
+}
+
+func formatPercentage(p float64) string {
+	return formatFloat(p) + "%"
+}
+
+// formatTrend formats the change of the deep share in percentage points
+// compared with the previous week.
+func formatTrend(weeks []period, idx int) string {
+	if idx == 0 {
+		return ""
+	}
+
+	delta := weeks[idx].deepShare() - weeks[idx-1].deepShare()
+	if delta >= 0 {
+		return "+" + formatFloat(delta)
+	}
+
+	return formatFloat(delta)
+}
+
+func printFocus(w io.Writer, days, weeks []period) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(days) == 0 {
+		table.Line("No entries.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row("date", "tracked", "switches", "longest", "avg session", "deep")
+
+	for _, day := range days {
+		table.Row(
+			day.name,
+			output.FormatDuration(day.tracked),
+			strconv.Itoa(day.switches),
+			output.FormatDuration(day.longest),
+			output.FormatDuration(day.averageSession()),
+			formatPercentage(day.deepShare()),
+		)
+	}
+
+	table.Line("")
+	table.Row(
+		"week",
+		"days",
+		"tracked",
+		"switches/day",
+		"longest",
+		"avg session",
+		"deep",
+		"trend",
+	)
+
+	for idx, week := range weeks {
+		table.Row(
+			week.name,
+			strconv.Itoa(week.days),
+			output.FormatDuration(week.tracked),
+			formatFloat(week.switchesPerDay()),
+			output.FormatDuration(week.longest),
+			output.FormatDuration(week.averageSession()),
+			formatPercentage(week.deepShare()),
+			formatTrend(weeks, idx),
+		)
+	}
+
+	table.Flush()
+}
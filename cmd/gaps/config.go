@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix     = "gaps"
+	configKeyBounds     = "bounds"
+	defaultBounds       = "entries"
+	configKeyHours      = "hours"
+	defaultHours        = "09:00-17:00"
+	configKeyMin        = "min"
+	defaultMin          = "5m"
+	configKeyTimezone   = "timezone"
+	defaultTimezone     = "local"
+	configKeyExclusions = "exclusions"
+	daysPerWeek         = 7
+)
+
+var (
+	errUnknownBounds = errors.New("unknown bounds")
+	errNegativeMin   = errors.New("minimum gap must not be negative")
+)
+
+// bounds defines what part of a day is checked for gaps.
+type bounds string
+
+const (
+	// boundsEntries checks from the first until the last entry of a day,
+	// or the whole report range if one is given.
+	boundsEntries bounds = "entries"
+	// boundsHours checks the configured working hours.
+	boundsHours bounds = "hours"
+	// boundsExclusions checks the time not excluded by timewarrior
+	// exclusions.
+	boundsExclusions bounds = "exclusions"
+)
+
+func parseBounds(value twext.ConfigValue) (bounds, error) {
+	b := bounds(value)
+	if !slices.Contains([]bounds{
+		boundsEntries,
+		boundsHours,
+		boundsExclusions,
+	}, b) {
+		return "", fmt.Errorf("%w: %s", errUnknownBounds, value)
+	}
+
+	return b, nil
+}
+
+type config struct {
+	bounds      bounds
+	schedule    schedule
+	minGap      time.Duration
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name ...string) twext.ConfigKey {
+	return twext.NewConfigKey(append([]string{configKeyPrefix}, name...)...)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	b, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyBounds),
+		defaultBounds,
+		parseBounds,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get bounds: %w", err)
+	}
+
+	sched, err := readSchedule(rawCfg, b)
+	if err != nil {
+		return config{}, fmt.Errorf("get schedule: %w", err)
+	}
+
+	minGap, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMin),
+		defaultMin,
+		parseMinGap,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get minimum gap: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		bounds:      b,
+		schedule:    sched,
+		minGap:      minGap,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Bounds:", cfg.bounds)
+	log.Println("cfg - Schedule:", cfg.schedule)
+	log.Println("cfg - MinGap:", cfg.minGap)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
+
+func isWeekend(day time.Weekday) bool {
+	return day == time.Saturday || day == time.Sunday
+}
+
+// readSchedule reads the ranges to check per weekday. It returns nil for
+// [boundsEntries], as the entries define the ranges then. The default working
+// hours apply from Monday to Friday only.
+func readSchedule(rawCfg twext.Config, b bounds) (schedule, error) {
+	var (
+		prefix   twext.ConfigKey
+		parse    func(twext.ConfigValue) ([]clockRange, error)
+		defValue twext.ConfigValue
+	)
+
+	switch b {
+	case boundsEntries:
+		return nil, nil
+	case boundsHours:
+		prefix = configKey(configKeyHours)
+		parse = parseWorkingHours
+		defValue = defaultHours
+
+		if value, exists := rawCfg[prefix]; exists {
+			defValue = value
+		}
+	case boundsExclusions:
+		prefix = configKeyExclusions
+		parse = parseExclusions
+	}
+
+	sched := make(schedule, daysPerWeek)
+
+	for day := range time.Weekday(daysPerWeek) {
+		key := twext.NewConfigKey(
+			prefix.String(),
+			strings.ToLower(day.String()),
+		)
+
+		value := defValue
+		if b == boundsHours && isWeekend(day) {
+			// Working hours apply to weekends only if set explicitly.
+			value = noHours
+		}
+
+		ranges, err := twext.ReadConfigValue(rawCfg, key, value, parse)
+		if err != nil {
+			return nil, fmt.Errorf("read ranges: %w", err)
+		}
+
+		sched[day] = ranges
+	}
+
+	return sched, nil
+}
+
+func parseMinGap(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegativeMin, duration)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+type dayGaps struct {
+	date  string
+	gaps  []twext.Interval
+	total time.Duration
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+// extendSpan extends the interval so it covers the entry as well.
+func extendSpan(span twext.Interval, entry twext.Entry) twext.Interval {
+	interval := entry.Interval()
+	if interval.Duration() == 0 {
+		return span
+	}
+
+	if span.Start.IsZero() || interval.Start.Before(span.Start) {
+		span.Start = interval.Start
+	}
+
+	if interval.End.After(span.End) {
+		span.End = interval.End
+	}
+
+	return span
+}
+
+// clip restricts the interval to the given bounds.
+func clip(interval, bounds twext.Interval) twext.Interval {
+	if interval.Start.Before(bounds.Start) {
+		interval.Start = bounds.Start
+	}
+
+	if interval.End.After(bounds.End) {
+		interval.End = bounds.End
+	}
+
+	return interval
+}
+
+// days returns the start of all days within the bounds in the given
+// location.
+func days(bounds twext.Interval, location *time.Location) []time.Time {
+	if bounds.Start.IsZero() {
+		return nil
+	}
+
+	var result []time.Time
+
+	day := twext.Midnight(bounds.Start.In(location))
+	for ; day.Before(bounds.End); day = day.AddDate(0, 0, 1) {
+		result = append(result, day)
+	}
+
+	return result
+}
+
+// hasReportRange reports whether a report range is given.
+func hasReportRange(cfg config) bool {
+	return !cfg.reportRange.Start.IsZero() || !cfg.reportRange.End.IsZero()
+}
+
+// rangeWindows returns the whole days within the report bounds, so leading
+// and trailing gaps within the report range are found as well.
+func rangeWindows(
+	entries twext.Entries,
+	cfg config,
+) twext.Aggregation[string, []twext.Interval] {
+	bounds := twext.ReportBounds(cfg.reportRange, entries, cfg.location)
+	result := make(twext.Aggregation[string, []twext.Interval])
+
+	for _, day := range days(bounds, cfg.location) {
+		window := clip(twext.Interval{
+			Start: day,
+			End:   day.AddDate(0, 0, 1),
+		}, bounds)
+		if window.Duration() > 0 {
+			date := day.Format(time.DateOnly)
+			result[date] = append(result[date], window)
+		}
+	}
+
+	return result
+}
+
+// windows returns the intervals per day that are checked for gaps.
+func windows(
+	entries twext.Entries,
+	cfg config,
+) twext.Aggregation[string, []twext.Interval] {
+	localEntries := twext.InLocation(entries.All(), cfg.location)
+	result := make(twext.Aggregation[string, []twext.Interval])
+
+	switch cfg.bounds {
+	case boundsEntries:
+		if hasReportRange(cfg) {
+			return rangeWindows(entries, cfg)
+		}
+
+		spans := twext.Aggregate(
+			twext.SplitAtMidnight(localEntries),
+			startDate,
+			extendSpan,
+		)
+
+		for date, span := range spans {
+			if span.Duration() > 0 {
+				result[date] = []twext.Interval{span}
+			}
+		}
+	case boundsHours, boundsExclusions:
+		bounds := twext.ReportBounds(cfg.reportRange, entries, cfg.location)
+
+		for _, day := range days(bounds, cfg.location) {
+			for _, window := range cfg.schedule.windows(day) {
+				window = clip(window, bounds)
+				if window.Duration() > 0 {
+					date := day.Format(time.DateOnly)
+					result[date] = append(result[date], window)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// findGaps returns the gaps per day that are at least as long as the
+// configured minimum. Days without gaps are omitted.
+func findGaps(entries twext.Entries, cfg config) []dayGaps {
+	intervals := make([]twext.Interval, 0, len(entries))
+	for entry := range twext.InLocation(entries.All(), cfg.location) {
+		intervals = append(intervals, entry.Interval())
+	}
+
+	var result []dayGaps
+
+	for date, dayWindows := range windows(entries, cfg).Sorted() {
+		day := dayGaps{date: date}
+
+		for _, window := range dayWindows {
+			for _, gap := range twext.Gaps(intervals, window) {
+				if gap.Duration() < cfg.minGap {
+					continue
+				}
+
+				day.gaps = append(day.gaps, gap)
+				day.total += gap.Duration()
+			}
+		}
+
+		if len(day.gaps) > 0 {
+			result = append(result, day)
+		}
+	}
+
+	return result
+}
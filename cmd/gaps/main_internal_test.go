@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+// testNow is Tuesday noon.
+var testNow = time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 9, 30), at(1, 12, 0), "a").
+		EntryAt(at(1, 13, 10), at(1, 14, 0), "b").
+		EntryAt(at(1, 14, 2), at(1, 14, 45), "b").
+		EntryAt(at(1, 14, 30), at(1, 16, 30), "c").
+		EntryAt(at(2, 9, 0), at(2, 10, 0), "a")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedErr    error
+	}{
+		{
+			name:        "invalid bounds",
+			input:       newTestInput().Config("gaps.bounds", "week"),
+			expectedErr: errUnknownBounds,
+		},
+		{
+			name: "invalid hours",
+			input: newTestInput().
+				Config("gaps.bounds", "hours").
+				Config("gaps.hours.monday", "17:00-09:00"),
+			expectedErr: errInvalidRange,
+		},
+		{
+			name:        "negative minimum",
+			input:       newTestInput().Config("gaps.min", "-1m"),
+			expectedErr: errNegativeMin,
+		},
+		{
+			name: "no gaps",
+			input: twexttest.NewInput(testNow).
+				Config(twext.ConfigKeyVerbose, "on").
+				EntryAt(at(1, 9, 0), at(1, 12, 0)),
+			expectedStdout: `
+No gaps.
+`,
+		},
+		{
+			name:  "between entries",
+			input: newTestInput(),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    12:00    13:10    1h:10m
+    2024-07-01               sum    1h:10m
+         total                      1h:10m
+`,
+		},
+		{
+			name:  "between entries without minimum",
+			input: newTestInput().Config("gaps.min", "0"),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    12:00    13:10    1h:10m
+    2024-07-01    14:00    14:02    0h:02m
+    2024-07-01               sum    1h:12m
+         total                      1h:12m
+`,
+		},
+		{
+			name: "between entries in report range",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240701T080000Z").
+				Config(twext.ConfigKeyReportEnd, "20240701T170000Z"),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    08:00    09:30    1h:30m
+    2024-07-01    12:00    13:10    1h:10m
+    2024-07-01    16:30    17:00    0h:30m
+    2024-07-01               sum    3h:10m
+         total                      3h:10m
+`,
+		},
+		{
+			name: "working hours",
+			input: newTestInput().
+				Config("gaps.bounds", "hours").
+				Config("gaps.hours", "08:00-12:00 13:00-17:00").
+				Config(twext.ConfigKeyReportStart, "20240630T000000Z").
+				Config(twext.ConfigKeyReportEnd, "20240703T000000Z"),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    08:00    09:30    1h:30m
+    2024-07-01    13:00    13:10    0h:10m
+    2024-07-01    16:30    17:00    0h:30m
+    2024-07-01               sum    2h:10m
+    2024-07-02    08:00    09:00    1h:00m
+    2024-07-02    10:00    12:00    2h:00m
+    2024-07-02               sum    3h:00m
+         total                      5h:10m
+`,
+		},
+		{
+			name: "exclusions",
+			input: newTestInput().
+				Config("gaps.bounds", "exclusions").
+				Config("exclusions.monday", "<9:00 12:00-13:00 >18:00").
+				Config("exclusions.tuesday", "<9:00 12:00-13:00 >18:00"),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    09:00    09:30    0h:30m
+    2024-07-01    13:00    13:10    0h:10m
+    2024-07-01    16:30    18:00    1h:30m
+    2024-07-01               sum    2h:10m
+    2024-07-02    10:00    12:00    2h:00m
+    2024-07-02               sum    2h:00m
+         total                      4h:10m
+`,
+		},
+		{
+			name: "without timezone",
+			input: twexttest.NewInput(testNow).
+				Config(twext.ConfigKeyVerbose, "on").
+				EntryAt(at(1, 21, 0), at(1, 21, 30)).
+				EntryAt(at(1, 22, 30), at(1, 23, 0)),
+			expectedStdout: `
+          date     from       to       gap
+    2024-07-01    21:30    22:30    1h:00m
+    2024-07-01               sum    1h:00m
+         total                      1h:00m
+`,
+		},
+		{
+			name: "timezone",
+			input: twexttest.NewInput(testNow).
+				Config("gaps.timezone", "Europe/Berlin").
+				EntryAt(at(1, 21, 0), at(1, 21, 30)).
+				EntryAt(at(1, 22, 30), at(1, 23, 0)),
+			expectedStdout: `
+No gaps.
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
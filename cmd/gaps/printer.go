@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const clockFormat = "15:04"
+
+func printGaps(w io.Writer, days []dayGaps) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(days) == 0 {
+		table.Line("No gaps.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row("date", "from", "to", "gap")
+
+	var total time.Duration
+
+	for _, day := range days {
+		for _, gap := range day.gaps {
+			table.Row(
+				day.date,
+				gap.Start.Format(clockFormat),
+				gap.End.Format(clockFormat),
+				output.FormatDuration(gap.Duration()),
+			)
+		}
+
+		table.Row(day.date, "", "sum", output.FormatDuration(day.total))
+
+		total += day.total
+	}
+
+	table.Row("total", "", "", output.FormatDuration(total))
+	table.Flush()
+}
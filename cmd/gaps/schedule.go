@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	minutesPerHour = 60
+	minutesPerDay  = 24 * minutesPerHour
+	noHours        = "none"
+)
+
+var errInvalidRange = errors.New("invalid time range")
+
+// clock is a time of day in minutes since midnight.
+type clock int
+
+// parseClock parses a time of day like "09:30". Seconds are accepted for
+// timewarrior's notation like "09:30:00", but must be zero, as gaps are
+// checked with minute precision.
+func parseClock(s string) (clock, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+
+		if t.Second() != 0 {
+			return 0, fmt.Errorf(
+				"%w: %q has seconds, only minutes are supported",
+				errInvalidRange,
+				s,
+			)
+		}
+
+		return clock(t.Hour()*minutesPerHour + t.Minute()), nil
+	}
+
+	return 0, fmt.Errorf("%w: %q is not a time of day", errInvalidRange, s)
+}
+
+func (c clock) on(day time.Time) time.Time {
+	return time.Date(
+		day.Year(), day.Month(), day.Day(),
+		int(c)/minutesPerHour, int(c)%minutesPerHour, 0, 0,
+		day.Location(),
+	)
+}
+
+func (c clock) String() string {
+	return fmt.Sprintf("%02d:%02d", c/minutesPerHour, c%minutesPerHour)
+}
+
+// clockRange is a range of the day from From until To.
+type clockRange struct {
+	from clock
+	to   clock
+}
+
+func (r clockRange) String() string {
+	return r.from.String() + "-" + r.to.String()
+}
+
+func parseClockRange(s string) (clockRange, error) {
+	fromStr, toStr, found := strings.Cut(s, "-")
+	if !found {
+		return clockRange{}, fmt.Errorf("%w: %q", errInvalidRange, s)
+	}
+
+	from, err := parseClock(fromStr)
+	if err != nil {
+		return clockRange{}, err
+	}
+
+	to, err := parseClock(toStr)
+	if err != nil {
+		return clockRange{}, err
+	}
+
+	if to <= from {
+		return clockRange{}, fmt.Errorf(
+			"%w: %q ends before start",
+			errInvalidRange,
+			s,
+		)
+	}
+
+	return clockRange{from, to}, nil
+}
+
+// parseWorkingHours parses space separated ranges like "09:00-12:00
+// 13:00-17:00". The value "none" and the empty value mean no working hours.
+func parseWorkingHours(value twext.ConfigValue) ([]clockRange, error) {
+	if value == noHours {
+		return nil, nil
+	}
+
+	var ranges []clockRange
+
+	for field := range strings.FieldsSeq(value.String()) {
+		r, err := parseClockRange(field)
+		if err != nil {
+			return nil, err
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+// parseExclusions parses timewarrior exclusions like "<8:00 12:00-12:45
+// >17:30" and returns the remaining ranges of the day.
+//
+// See https://timewarrior.net/docs/api/#exclusions.
+func parseExclusions(value twext.ConfigValue) ([]clockRange, error) {
+	var excluded []clockRange
+
+	for field := range strings.FieldsSeq(value.String()) {
+		var (
+			r   clockRange
+			err error
+		)
+
+		switch {
+		case strings.HasPrefix(field, "<"):
+			r.to, err = parseClock(field[1:])
+		case strings.HasPrefix(field, ">"):
+			r.from, err = parseClock(field[1:])
+			r.to = minutesPerDay
+		default:
+			r, err = parseClockRange(field)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		excluded = append(excluded, r)
+	}
+
+	slices.SortFunc(excluded, func(a, b clockRange) int {
+		return int(a.from - b.from)
+	})
+
+	var (
+		ranges []clockRange
+		cursor clock
+	)
+
+	for _, r := range excluded {
+		if r.from > cursor {
+			ranges = append(ranges, clockRange{cursor, r.from})
+		}
+
+		cursor = max(cursor, r.to)
+	}
+
+	if cursor < minutesPerDay {
+		ranges = append(ranges, clockRange{cursor, minutesPerDay})
+	}
+
+	return ranges, nil
+}
+
+// schedule holds the time ranges per weekday that should be tracked.
+type schedule map[time.Weekday][]clockRange
+
+func (s schedule) String() string {
+	str := &strings.Builder{}
+
+	for day := range time.Weekday(daysPerWeek) {
+		if str.Len() > 0 {
+			str.WriteString(", ")
+		}
+
+		_, _ = fmt.Fprintf(str, "%s: %v", day, s[day])
+	}
+
+	return str.String()
+}
+
+// windows returns the intervals of the given day that should be tracked.
+func (s schedule) windows(day time.Time) []twext.Interval {
+	ranges := s[day.Weekday()]
+	windows := make([]twext.Interval, 0, len(ranges))
+
+	for _, r := range ranges {
+		windows = append(windows, twext.Interval{
+			Start: r.from.on(day),
+			End:   r.to.on(day),
+		})
+	}
+
+	return windows
+}
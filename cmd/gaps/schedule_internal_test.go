@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    string
+		expectedErr error
+	}{
+		{input: "", expected: "[]"},
+		{input: "none", expected: "[]"},
+		{input: "09:00-17:00", expected: "[09:00-17:00]"},
+		{
+			input:    "8:00-12:00 12:30-16:30",
+			expected: "[08:00-12:00 12:30-16:30]",
+		},
+		{input: "09:00:00-17:00:00", expected: "[09:00-17:00]"},
+		{input: "09:00:30-17:00", expectedErr: errInvalidRange},
+		{input: "09:00", expectedErr: errInvalidRange},
+		{input: "17:00-09:00", expectedErr: errInvalidRange},
+		{input: "9-17", expectedErr: errInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parseWorkingHours(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expected, fmt.Sprint(actual))
+		})
+	}
+}
+
+func TestParseExclusions(t *testing.T) {
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    string
+		expectedErr error
+	}{
+		{input: "", expected: "[00:00-24:00]"},
+		{input: "<8:00 >17:30", expected: "[08:00-17:30]"},
+		{
+			input:    ">17:30 12:00-12:45 <8:00",
+			expected: "[08:00-12:00 12:45-17:30]",
+		},
+		{
+			input:    "<8:00 7:00-9:00 >18:00",
+			expected: "[09:00-18:00]",
+		},
+		{input: "<8", expectedErr: errInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parseExclusions(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expected, fmt.Sprint(actual))
+		})
+	}
+}
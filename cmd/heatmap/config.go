@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/timetarget"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "heatmap"
+	configKeyBuckets  = "buckets"
+	defaultBuckets    = bucketsTarget
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+	defaultColor      = "on"
+
+	// bucketsTarget uses thresholds relative to the daily flextime target.
+	bucketsTarget = "target"
+)
+
+var errInvalidBuckets = errors.New("invalid buckets")
+
+type config struct {
+	// buckets are the lower bounds of the upper levels. If nil, the
+	// thresholds are relative to the daily time targets.
+	buckets     []time.Duration
+	targets     timetarget.Targets
+	color       bool
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseBuckets parses a space separated list of ascending durations, one for
+// each level above the lowest one, like "2h 4h 6h". The value "target" results
+// in nil buckets.
+func parseBuckets(value twext.ConfigValue) ([]time.Duration, error) {
+	if value == bucketsTarget {
+		return nil, nil
+	}
+
+	fields := strings.Fields(value.String())
+	if len(fields) != len(relativeThresholds) {
+		return nil, fmt.Errorf(
+			"%w: need %d durations, got %d",
+			errInvalidBuckets,
+			len(relativeThresholds),
+			len(fields),
+		)
+	}
+
+	buckets := make([]time.Duration, 0, len(fields))
+
+	for _, field := range fields {
+		duration, err := time.ParseDuration(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidBuckets, err)
+		}
+
+		// Each threshold must be greater than the previous one, which also
+		// rejects negative and duplicate thresholds.
+		lowerBound := time.Duration(0)
+		if len(buckets) > 0 {
+			lowerBound = buckets[len(buckets)-1]
+		}
+
+		if duration <= lowerBound {
+			return nil, fmt.Errorf(
+				"%w: must be positive and strictly ascending: %s",
+				errInvalidBuckets,
+				value,
+			)
+		}
+
+		buckets = append(buckets, duration)
+	}
+
+	return buckets, nil
+}
+
+func parseColor(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	buckets, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyBuckets),
+		defaultBuckets,
+		parseBuckets,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get buckets: %w", err)
+	}
+
+	targets, err := timetarget.Read(rawCfg)
+	if err != nil {
+		return config{}, fmt.Errorf("get time target: %w", err)
+	}
+
+	color, err := twext.ReadConfigValue(
+		rawCfg,
+		twext.ConfigKeyColor,
+		defaultColor,
+		parseColor,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get color: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		buckets:     buckets,
+		targets:     targets,
+		color:       color,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Buckets:", cfg.buckets)
+	log.Println("cfg - Targets:", cfg.targets)
+	log.Println("cfg - Color:", cfg.color)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	daysPerWeek = 7
+	percent     = 100
+)
+
+// relativeThresholds are the lower bounds of the upper levels in percent of
+// the daily time target.
+//
+//nolint:gochecknoglobals
+var relativeThresholds = [...]int64{50, 75, 100}
+
+// Days without any tracked time have level 0, days with any tracked time
+// have at least level 1. Each reached threshold adds another level.
+const (
+	firstThresholdLevel = 2
+	maxLevel            = firstThresholdLevel + len(relativeThresholds) - 1
+)
+
+type day struct {
+	date     time.Time
+	duration time.Duration
+	level    int
+}
+
+// heatmap holds all consecutive days of the report range.
+type heatmap struct {
+	days        []day
+	total       time.Duration
+	trackedDays int
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func sumDuration(result time.Duration, entry twext.Entry) time.Duration {
+	return result + entry.Duration()
+}
+
+// weekdayIndex returns the index of the weekday with Monday as 0 and Sunday
+// as 6.
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + daysPerWeek - 1) % daysPerWeek
+}
+
+// thresholds returns the lower bounds of the upper levels for the given day.
+func (c config) thresholds(date time.Time) []time.Duration {
+	if c.buckets != nil {
+		return c.buckets
+	}
+
+	target := c.targets.For(date)
+	thresholds := make([]time.Duration, 0, len(relativeThresholds))
+
+	for _, threshold := range relativeThresholds {
+		thresholds = append(thresholds, target*time.Duration(threshold)/percent)
+	}
+
+	return thresholds
+}
+
+// level returns the level for the tracked duration. Any tracked time is at
+// least level 1 and each reached threshold adds another level.
+func level(duration time.Duration, thresholds []time.Duration) int {
+	if duration <= 0 {
+		return 0
+	}
+
+	result := 1
+
+	for _, threshold := range thresholds {
+		if duration >= threshold {
+			result++
+		}
+	}
+
+	return result
+}
+
+// buildHeatmap sums up the tracked time for each day of the report range.
+// Entries are clipped to the report range and split at midnight, so each
+// part is accounted for its own day.
+func buildHeatmap(entries twext.Entries, cfg config) heatmap {
+	bounds := twext.ReportBounds(cfg.reportRange, entries, cfg.location)
+	durations := twext.Aggregate(
+		twext.SplitAtMidnight(twext.InLocation(
+			twext.ClipEntries(entries.All(), bounds),
+			cfg.location,
+		)),
+		startDate,
+		sumDuration,
+	)
+
+	var result heatmap
+
+	if bounds.Start.IsZero() {
+		return result
+	}
+
+	date := twext.Midnight(bounds.Start.In(cfg.location))
+	for ; date.Before(bounds.End); date = date.AddDate(0, 0, 1) {
+		duration := durations[date.Format(time.DateOnly)]
+
+		result.days = append(result.days, day{
+			date:     date,
+			duration: duration,
+			level:    level(duration, cfg.thresholds(date)),
+		})
+		result.total += duration
+
+		if duration > 0 {
+			result.trackedDays++
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevel(t *testing.T) {
+	thresholds := []time.Duration{2 * time.Hour, 4 * time.Hour, 6 * time.Hour}
+
+	tests := []struct {
+		duration time.Duration
+		expected int
+	}{
+		{duration: 0, expected: 0},
+		{duration: time.Minute, expected: 1},
+		{duration: 2 * time.Hour, expected: 2},
+		{duration: 5 * time.Hour, expected: 3},
+		{duration: 6 * time.Hour, expected: 4},
+		{duration: 12 * time.Hour, expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, level(tt.duration, thresholds))
+		})
+	}
+}
+
+func TestParseBuckets(t *testing.T) {
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    []time.Duration
+		expectedErr error
+	}{
+		{input: "target"},
+		{
+			input: " 1h  2h30m 5h ",
+			expected: []time.Duration{
+				time.Hour,
+				150 * time.Minute,
+				5 * time.Hour,
+			},
+		},
+		{input: "1h 2h", expectedErr: errInvalidBuckets},
+		{input: "1h 2h 3h 4h", expectedErr: errInvalidBuckets},
+		{input: "1h 2x 3h", expectedErr: errInvalidBuckets},
+		{input: "3h 2h 4h", expectedErr: errInvalidBuckets},
+		{input: "0h 2h 4h", expectedErr: errInvalidBuckets},
+		{input: "-1h 2h 4h", expectedErr: errInvalidBuckets},
+		{input: "-2h -1h 4h", expectedErr: errInvalidBuckets},
+		{input: "1h 2h 2h", expectedErr: errInvalidBuckets},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parseBuckets(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyColor, "off").
+		EntryAt(at(6, 12, 9, 0), at(6, 12, 13, 0), "projA").
+		EntryAt(at(6, 14, 9, 0), at(6, 14, 15, 30), "projA").
+		EntryAt(at(6, 17, 8, 0), at(6, 17, 17, 0), "projB").
+		EntryAt(at(6, 21, 22, 0), at(6, 22, 2, 0), "projB").
+		EntryAt(at(7, 1, 9, 0), at(7, 1, 10, 0), "projA").
+		EntryAt(at(7, 3, 9, 0), at(7, 3, 16, 0), "projA", "projB").
+		EntryAt(at(7, 5, 9, 0), at(7, 5, 13, 0))
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "invalid buckets",
+			input:       newTestInput().Config("heatmap.buckets", "2h 1h 3h"),
+			expectedErr: errInvalidBuckets,
+		},
+		{
+			name:  "monochrome",
+			input: newTestInput(),
+		},
+		{
+			name:  "color",
+			input: newTestInput().Config(twext.ConfigKeyColor, "on"),
+		},
+		{
+			name:  "buckets",
+			input: newTestInput().Config("heatmap.buckets", "2h 4h 6h"),
+		},
+		{
+			name: "weekday targets",
+			input: newTestInput().
+				Config("flextime.time_per_day", "6h").
+				Config("flextime.time_per_day.friday", "4h"),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240501T000000Z").
+				Config(twext.ConfigKeyReportEnd, "20240620T000000Z"),
+		},
+		{
+			name: "timezone",
+			input: newTestInput().
+				Config("heatmap.timezone", "America/New_York"),
+		},
+		{
+			name: "empty",
+			input: twexttest.NewInput(testNow).
+				Config(twext.ConfigKeyColor, "off"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
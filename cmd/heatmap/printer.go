@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const (
+	// cellWidth is the width of a week column including the separator.
+	cellWidth   = 2
+	labelWidth  = 4
+	labelLength = 3
+	ansiReset   = "\x1b[0m"
+	colorBlock  = "█"
+	outsideCell = " "
+)
+
+// monochromeBlocks are the cells per level if color is off.
+//
+//nolint:gochecknoglobals
+var monochromeBlocks = [maxLevel + 1]string{"·", "░", "▒", "▓", "█"}
+
+// colors are the ANSI 256 color escape sequences per level.
+//
+//nolint:gochecknoglobals
+var colors = [maxLevel + 1]string{
+	"\x1b[38;5;237m",
+	"\x1b[38;5;22m",
+	"\x1b[38;5;28m",
+	"\x1b[38;5;34m",
+	"\x1b[38;5;40m",
+}
+
+type printer struct {
+	color bool
+}
+
+func (p printer) cell(level int) string {
+	if p.color {
+		return colors[level] + colorBlock + ansiReset
+	}
+
+	return monochromeBlocks[level]
+}
+
+// grid returns the day index for the given week column and weekday row. It
+// returns false if the position is outside of the report range.
+func (h heatmap) grid(week, weekday int) (int, bool) {
+	idx := week*daysPerWeek + weekday - weekdayIndex(h.days[0].date)
+
+	return idx, idx >= 0 && idx < len(h.days)
+}
+
+func (h heatmap) weeks() int {
+	offset := weekdayIndex(h.days[0].date)
+
+	return (offset + len(h.days) + daysPerWeek - 1) / daysPerWeek
+}
+
+// monthLabels returns the line with the month names above the weeks the
+// months start in. The first column is labeled with the month of the first
+// day. If labels would overlap, the earlier one is dropped.
+func (h heatmap) monthLabels() string {
+	line := []rune(strings.Repeat(" ", labelWidth+h.weeks()*cellWidth))
+	lastStart := -labelWidth
+
+	for week := range h.weeks() {
+		label := ""
+
+		for weekday := range daysPerWeek {
+			idx, inRange := h.grid(week, weekday)
+			if inRange && (idx == 0 || h.days[idx].date.Day() == 1) {
+				label = h.days[idx].date.Month().String()[:labelLength]
+			}
+		}
+
+		if label == "" {
+			continue
+		}
+
+		start := labelWidth + week*cellWidth
+		if start <= lastStart+labelLength {
+			copy(line[lastStart:], []rune(strings.Repeat(" ", labelLength)))
+		}
+
+		copy(line[start:], []rune(label))
+		lastStart = start
+	}
+
+	return strings.TrimRight(string(line), " ")
+}
+
+func (p printer) rows(h heatmap) []string {
+	rows := make([]string, 0, daysPerWeek)
+
+	for weekday := range daysPerWeek {
+		cells := make([]string, 0, h.weeks())
+
+		for week := range h.weeks() {
+			idx, inRange := h.grid(week, weekday)
+			if !inRange {
+				cells = append(cells, outsideCell)
+
+				continue
+			}
+
+			cells = append(cells, p.cell(h.days[idx].level))
+		}
+
+		name := time.Weekday((weekday + 1) % daysPerWeek).String()
+		row := name[:labelLength] + " " + strings.Join(cells, " ")
+		rows = append(rows, strings.TrimRight(row, " "))
+	}
+
+	return rows
+}
+
+// legend describes the levels with their thresholds.
+func (p printer) legend(cfg config) string {
+	legend := &strings.Builder{}
+	legend.WriteString("Legend: " + p.cell(0) + " none  " + p.cell(1))
+
+	for idx, threshold := range relativeThresholds {
+		value := fmt.Sprintf("%d%%", threshold)
+		if cfg.buckets != nil {
+			value = output.FormatDuration(cfg.buckets[idx])
+		}
+
+		level := firstThresholdLevel + idx
+		legend.WriteString(" < " + value + " ≤ " + p.cell(level))
+	}
+
+	if cfg.buckets == nil {
+		legend.WriteString(" of daily target")
+	}
+
+	return legend.String()
+}
+
+func printHeatmap(w io.Writer, h heatmap, cfg config) {
+	lines := []string{}
+
+	if len(h.days) == 0 {
+		lines = append(lines, "No days in report range.")
+	} else {
+		p := printer{color: cfg.color}
+
+		lines = append(lines, h.monthLabels())
+		lines = append(lines, p.rows(h)...)
+		lines = append(lines, "", p.legend(cfg), fmt.Sprintf(
+			"Total: %s on %d of %d days",
+			output.FormatDuration(h.total),
+			h.trackedDays,
+			len(h.days),
+		))
+	}
+
+	for _, line := range lines {
+		_, err := fmt.Fprintln(w, line)
+		if err != nil {
+			panic(fmt.Errorf("fprintln: %w", err))
+		}
+	}
+}
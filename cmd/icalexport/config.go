@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "icalexport"
+	configKeySummary  = "summary"
+	defaultSummary    = "tags"
+	configKeyMerge    = "merge"
+	defaultMerge      = "off"
+	configKeyMergeGap = "merge_gap"
+	defaultMergeGap   = "0s"
+	configKeyDomain   = "domain"
+	defaultDomain     = "timewarrior"
+)
+
+var (
+	errUnknownSummary   = errors.New("unknown summary")
+	errNegativeMergeGap = errors.New("merge gap must not be negative")
+	errEmptyDomain      = errors.New("domain must not be empty")
+)
+
+// summary defines what the summary of an event is made of.
+type summary string
+
+const (
+	// summaryTags uses the tags and falls back to the annotation.
+	summaryTags summary = "tags"
+	// summaryAnnotation uses the annotation and falls back to the tags.
+	summaryAnnotation summary = "annotation"
+)
+
+func parseSummary(value twext.ConfigValue) (summary, error) {
+	s := summary(value)
+	if !slices.Contains([]summary{summaryTags, summaryAnnotation}, s) {
+		return "", fmt.Errorf("%w: %s", errUnknownSummary, value)
+	}
+
+	return s, nil
+}
+
+func parseBool(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parseMergeGap(value twext.ConfigValue) (time.Duration, error) {
+	gap, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if gap < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegativeMergeGap, gap)
+	}
+
+	return gap, nil
+}
+
+func parseDomain(value twext.ConfigValue) (string, error) {
+	if value == "" {
+		return "", errEmptyDomain
+	}
+
+	return value.String(), nil
+}
+
+type config struct {
+	summary  summary
+	merge    bool
+	mergeGap time.Duration
+	domain   string
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	s, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeySummary),
+		defaultSummary,
+		parseSummary,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get summary: %w", err)
+	}
+
+	merge, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMerge),
+		defaultMerge,
+		parseBool,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get merge: %w", err)
+	}
+
+	mergeGap, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMergeGap),
+		defaultMergeGap,
+		parseMergeGap,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get merge gap: %w", err)
+	}
+
+	domain, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyDomain),
+		defaultDomain,
+		parseDomain,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get domain: %w", err)
+	}
+
+	cfg := config{
+		summary:  s,
+		merge:    merge,
+		mergeGap: mergeGap,
+		domain:   domain,
+	}
+
+	log.Println("cfg - Summary:", cfg.summary)
+	log.Println("cfg - Merge:", cfg.merge)
+	log.Println("cfg - MergeGap:", cfg.mergeGap)
+	log.Println("cfg - Domain:", cfg.domain)
+
+	return cfg, nil
+}
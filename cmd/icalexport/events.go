@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	untaggedSummary = "(untagged)"
+	uidHashLength   = 16
+)
+
+// event is a calendar event made of one or more entries.
+type event struct {
+	// id is the ID of the first entry of the event.
+	id          int
+	start       time.Time
+	end         time.Time
+	tags        []string
+	annotations []string
+}
+
+func newEvent(entry twext.Entry) event {
+	e := event{
+		id:    entry.ID,
+		start: entry.Start.Time,
+		end:   entry.CurrentEnd().Time,
+		tags:  slices.Compact(slices.Sorted(slices.Values(entry.Tags))),
+	}
+
+	if annotation := strings.TrimSpace(entry.Annotation); annotation != "" {
+		e.annotations = []string{annotation}
+	}
+
+	return e
+}
+
+func compareEvents(a, b event) int {
+	return cmp.Or(
+		a.start.Compare(b.start),
+		slices.Compare(a.tags, b.tags),
+		a.end.Compare(b.end),
+	)
+}
+
+// uid returns a unique identifier derived from the entry ID, the start and
+// end time and the tags. Entries with identical start and tags, like
+// duplicates, get distinct identifiers. Unchanged entries keep theirs across
+// exports.
+func (e event) uid(domain string) string {
+	hash := sha256.New()
+	hash.Write([]byte(strconv.Itoa(e.id)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(formatDateTime(e.start)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(formatDateTime(e.end)))
+
+	for _, tag := range e.tags {
+		hash.Write([]byte{0})
+		hash.Write([]byte(tag))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)[:uidHashLength]) + "@" + domain
+}
+
+// summary returns the summary of the event. If the preferred source is
+// empty, the other one is used.
+func (e event) summary(preferred summary) string {
+	sources := []string{
+		strings.Join(e.tags, " "),
+		strings.Join(e.annotations, "; "),
+	}
+
+	if preferred == summaryAnnotation {
+		slices.Reverse(sources)
+	}
+
+	for _, source := range sources {
+		if source != "" {
+			return source
+		}
+	}
+
+	return untaggedSummary
+}
+
+// adjacent returns true if the next event starts at most gap after the end of
+// the event.
+func (e event) adjacent(next event, gap time.Duration) bool {
+	distance := next.start.Sub(e.end)
+
+	return distance >= 0 && distance <= gap
+}
+
+// mergeEvents merges adjacent events with identical tags into a single
+// event. The events must be sorted.
+func mergeEvents(events []event, gap time.Duration) []event {
+	merged := make([]event, 0, len(events))
+
+	for _, e := range events {
+		if len(merged) == 0 {
+			merged = append(merged, e)
+
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+		if !slices.Equal(last.tags, e.tags) || !last.adjacent(e, gap) {
+			merged = append(merged, e)
+
+			continue
+		}
+
+		last.end = e.end
+
+		for _, annotation := range e.annotations {
+			if !slices.Contains(last.annotations, annotation) {
+				last.annotations = append(last.annotations, annotation)
+			}
+		}
+	}
+
+	return merged
+}
+
+// buildEvents returns an event per entry sorted by start time. If merging is
+// enabled, adjacent events with identical tags are merged. Entries ending
+// before they start are skipped, as they would make invalid events.
+func buildEvents(entries twext.Entries, cfg config) []event {
+	events := make([]event, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.CurrentEnd().Before(entry.Start.Time) {
+			log.Printf("entry %d ends before it starts. Skipping.", entry.ID)
+
+			continue
+		}
+
+		events = append(events, newEvent(entry))
+	}
+
+	slices.SortFunc(events, compareEvents)
+
+	if cfg.merge {
+		events = mergeEvents(events, cfg.mergeGap)
+	}
+
+	return events
+}
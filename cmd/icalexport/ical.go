@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxLineOctets is the maximum length of a content line without the line
+	// break as defined in RFC 5545 section 3.1.
+	maxLineOctets = 75
+	lineBreak     = "\r\n"
+	dateTimeUTC   = "20060102T150405Z"
+)
+
+//nolint:gochecknoglobals
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes a TEXT value as defined in RFC 5545 section 3.3.11.
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// escapeTextList escapes the values and joins them into a comma separated
+// list of TEXT values.
+func escapeTextList(values []string) string {
+	escaped := make([]string, 0, len(values))
+
+	for _, value := range values {
+		escaped = append(escaped, escapeText(value))
+	}
+
+	return strings.Join(escaped, ",")
+}
+
+// formatDateTime formats the time as DATE-TIME value in UTC.
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeUTC)
+}
+
+// foldLine folds a content line so no line is longer than [maxLineOctets].
+// Continuation lines start with a single space. Multi-octet UTF-8 sequences
+// are never split.
+func foldLine(line string) string {
+	folded := &strings.Builder{}
+	width := 0
+
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > maxLineOctets {
+			folded.WriteString(lineBreak + " ")
+
+			width = 1
+		}
+
+		folded.WriteRune(r)
+
+		width += size
+	}
+
+	return folded.String()
+}
+
+// contentWriter writes content lines.
+type contentWriter struct {
+	w io.Writer
+}
+
+// property writes a property with the already escaped value.
+func (c contentWriter) property(name, value string) {
+	_, err := io.WriteString(c.w, foldLine(name+":"+value)+lineBreak)
+	if err != nil {
+		panic(fmt.Errorf("write: %w", err))
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "plain text", expected: "plain text"},
+		{input: `a\b`, expected: `a\\b`},
+		{input: "a;b,c", expected: `a\;b\,c`},
+		{input: "a\nb\r\nc\rd", expected: `a\nb\nc\nd`},
+		{input: `:"colon" and quotes`, expected: `:"colon" and quotes`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.expected, escapeText(tt.input))
+		})
+	}
+}
+
+func TestFoldLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "short",
+			input:    "SUMMARY:short",
+			expected: []string{"SUMMARY:short"},
+		},
+		{
+			name:     "exactly 75 octets",
+			input:    strings.Repeat("a", 75),
+			expected: []string{strings.Repeat("a", 75)},
+		},
+		{
+			name:  "ascii",
+			input: strings.Repeat("a", 160),
+			expected: []string{
+				strings.Repeat("a", 75),
+				" " + strings.Repeat("a", 74),
+				" " + strings.Repeat("a", 11),
+			},
+		},
+		{
+			name:  "multi-octet characters are not split",
+			input: strings.Repeat("a", 74) + "äb",
+			expected: []string{
+				strings.Repeat("a", 74),
+				" äb",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := foldLine(tt.input)
+			assert.Equal(t, strings.Join(tt.expected, "\r\n"), actual)
+
+			for _, line := range strings.Split(actual, "\r\n") {
+				assert.LessOrEqual(t, len(line), maxLineOctets)
+			}
+		})
+	}
+}
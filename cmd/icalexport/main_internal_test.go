@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 10, 0), at(1, 11, 0), "dev", "acme").
+		Annotate("review, part 2").
+		EntryAt(at(1, 9, 0), at(1, 10, 0), "acme", "dev").
+		Annotate("planning").
+		EntryAt(at(1, 11, 15), at(1, 12, 0), "acme", "dev").
+		EntryAt(at(1, 13, 0), at(1, 13, 30)).
+		Annotate("lunch; walk")
+}
+
+// calendar returns the lines of a calendar with the given content lines.
+func calendar(lines ...string) string {
+	lines = append([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		`PRODID:-//aibor//timewarrior-extensions icalexport//EN`,
+		"CALSCALE:GREGORIAN",
+	}, lines...)
+	lines = append(lines, "END:VCALENDAR", "")
+
+	return strings.Join(lines, "\r\n")
+}
+
+// vevent returns the lines of an event on 2024-07-01 with the given hash
+// part of the UID, start and end time as hhmm and further content lines.
+func vevent(hash, start, end string, lines ...string) []string {
+	return slices.Concat(
+		[]string{
+			"BEGIN:VEVENT",
+			"UID:" + hash + "@timewarrior",
+			"DTSTAMP:20240702T120000Z",
+			"DTSTART:20240701T" + start + "00Z",
+			"DTEND:20240701T" + end + "00Z",
+		},
+		lines,
+		[]string{"END:VEVENT"},
+	)
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedErr    error
+	}{
+		{
+			name:        "unknown summary",
+			input:       newTestInput().Config("icalexport.summary", "id"),
+			expectedErr: errUnknownSummary,
+		},
+		{
+			name: "negative merge gap",
+			input: newTestInput().
+				Config("icalexport.merge_gap", "-1m"),
+			expectedErr: errNegativeMergeGap,
+		},
+		{
+			name:        "empty domain",
+			input:       newTestInput().Config("icalexport.domain", ""),
+			expectedErr: errEmptyDomain,
+		},
+		{
+			name:           "no entries",
+			input:          twexttest.NewInput(testNow).Config("debug", "off"),
+			expectedStdout: calendar(),
+		},
+		{
+			name: "duplicate entries",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				EntryAt(at(1, 9, 0), at(1, 10, 0), "acme").
+				EntryAt(at(1, 9, 0), at(1, 10, 0), "acme"),
+			expectedStdout: calendar(slices.Concat(
+				vevent("3284b35beb0eeb06bf5f319b1cd3c461", "0900", "1000",
+					"SUMMARY:acme",
+					"CATEGORIES:acme",
+				),
+				vevent("d2f9946f3a2d60d01f8b50ea644737f7", "0900", "1000",
+					"SUMMARY:acme",
+					"CATEGORIES:acme",
+				),
+			)...),
+		},
+		{
+			name: "end before start",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				EntryAt(at(1, 9, 0), at(1, 10, 0), "acme").
+				EntryAt(at(1, 12, 0), at(1, 11, 0), "acme"),
+			expectedStdout: calendar(
+				vevent("3284b35beb0eeb06bf5f319b1cd3c461", "0900", "1000",
+					"SUMMARY:acme",
+					"CATEGORIES:acme",
+				)...,
+			),
+		},
+		{
+			name:  "events",
+			input: newTestInput(),
+			expectedStdout: calendar(slices.Concat(
+				vevent("24f2c9b0ae4909ed5ef2188090aac572", "0900", "1000",
+					"SUMMARY:acme dev",
+					"DESCRIPTION:planning",
+					"CATEGORIES:acme,dev",
+				),
+				vevent("4418cdcaa97d9556708f882f08c97d8b", "1000", "1100",
+					"SUMMARY:acme dev",
+					`DESCRIPTION:review\, part 2`,
+					"CATEGORIES:acme,dev",
+				),
+				vevent("fb64d4660d9808acc76e7f178e487a7e", "1115", "1200",
+					"SUMMARY:acme dev",
+					"CATEGORIES:acme,dev",
+				),
+				vevent("8fce9dd019c499394a8ca71484c49cda", "1300", "1330",
+					`SUMMARY:lunch\; walk`,
+					`DESCRIPTION:lunch\; walk`,
+				),
+			)...),
+		},
+		{
+			name: "summary from annotation and domain",
+			input: newTestInput().
+				Config("icalexport.summary", "annotation").
+				Config("icalexport.domain", "example.com"),
+			expectedStdout: strings.ReplaceAll(calendar(slices.Concat(
+				vevent("24f2c9b0ae4909ed5ef2188090aac572", "0900", "1000",
+					"SUMMARY:planning",
+					"DESCRIPTION:planning",
+					"CATEGORIES:acme,dev",
+				),
+				vevent("4418cdcaa97d9556708f882f08c97d8b", "1000", "1100",
+					`SUMMARY:review\, part 2`,
+					`DESCRIPTION:review\, part 2`,
+					"CATEGORIES:acme,dev",
+				),
+				vevent("fb64d4660d9808acc76e7f178e487a7e", "1115", "1200",
+					"SUMMARY:acme dev",
+					"CATEGORIES:acme,dev",
+				),
+				vevent("8fce9dd019c499394a8ca71484c49cda", "1300", "1330",
+					`SUMMARY:lunch\; walk`,
+					`DESCRIPTION:lunch\; walk`,
+				),
+			)...), "@timewarrior", "@example.com"),
+		},
+		{
+			name:  "merge",
+			input: newTestInput().Config("icalexport.merge", "on"),
+			expectedStdout: calendar(slices.Concat(
+				vevent("bbab79054c1570ea28ee03b1bc7f163a", "0900", "1100",
+					"SUMMARY:acme dev",
+					`DESCRIPTION:planning\nreview\, part 2`,
+					"CATEGORIES:acme,dev",
+				),
+				vevent("fb64d4660d9808acc76e7f178e487a7e", "1115", "1200",
+					"SUMMARY:acme dev",
+					"CATEGORIES:acme,dev",
+				),
+				vevent("8fce9dd019c499394a8ca71484c49cda", "1300", "1330",
+					`SUMMARY:lunch\; walk`,
+					`DESCRIPTION:lunch\; walk`,
+				),
+			)...),
+		},
+		{
+			name: "merge with gap",
+			input: newTestInput().
+				Config("icalexport.merge", "on").
+				Config("icalexport.merge_gap", "15m"),
+			expectedStdout: calendar(slices.Concat(
+				vevent("bc80db74df92984638e8fade9744e07a", "0900", "1200",
+					"SUMMARY:acme dev",
+					`DESCRIPTION:planning\nreview\, part 2`,
+					"CATEGORIES:acme,dev",
+				),
+				vevent("8fce9dd019c499394a8ca71484c49cda", "1300", "1330",
+					`SUMMARY:lunch\; walk`,
+					`DESCRIPTION:lunch\; walk`,
+				),
+			)...),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+const productID = "-//aibor//timewarrior-extensions icalexport//EN"
+
+// printCalendar writes the events as VCALENDAR object as defined in RFC 5545.
+// All times are in UTC, so no time zone definitions are needed.
+func printCalendar(w io.Writer, events []event, cfg config, now time.Time) {
+	c := contentWriter{w}
+
+	c.property("BEGIN", "VCALENDAR")
+	c.property("VERSION", "2.0")
+	c.property("PRODID", escapeText(productID))
+	c.property("CALSCALE", "GREGORIAN")
+
+	for _, e := range events {
+		c.property("BEGIN", "VEVENT")
+		c.property("UID", escapeText(e.uid(cfg.domain)))
+		c.property("DTSTAMP", formatDateTime(now))
+		c.property("DTSTART", formatDateTime(e.start))
+		c.property("DTEND", formatDateTime(e.end))
+		c.property("SUMMARY", escapeText(e.summary(cfg.summary)))
+
+		if len(e.annotations) > 0 {
+			description := strings.Join(e.annotations, "\n")
+			c.property("DESCRIPTION", escapeText(description))
+		}
+
+		if len(e.tags) > 0 {
+			c.property("CATEGORIES", escapeTextList(e.tags))
+		}
+
+		c.property("END", "VEVENT")
+	}
+
+	c.property("END", "VCALENDAR")
+}
@@ -0,0 +1,325 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "invoice"
+	configKeyRate     = "rate"
+	configKeyTax      = "tax"
+	defaultTax        = "0"
+	configKeyRoundTo  = "round_to"
+	defaultRoundTo    = "0"
+	configKeyRoundPer = "round_per"
+	defaultRoundPer   = "entry"
+	configKeyRounding = "rounding"
+	defaultRounding   = "up"
+	configKeyGroupBy  = "group_by"
+	defaultGroupBy    = "day"
+	configKeyFormat   = "format"
+	defaultFormat     = "text"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+	rateFields        = 2
+)
+
+var (
+	errNoRates       = errors.New("no rates configured")
+	errInvalidRate   = errors.New("rate must be amount and currency")
+	errNegative      = errors.New("must not be negative")
+	errUnknownOption = errors.New("unknown option")
+)
+
+type rate struct {
+	amount   decimal.Decimal
+	currency string
+}
+
+func (r rate) String() string {
+	return r.amount.Format(moneyPlaces) + " " + r.currency
+}
+
+type roundingUnit string
+
+const (
+	roundPerEntry roundingUnit = "entry"
+	roundPerDay   roundingUnit = "day"
+)
+
+type roundingMode string
+
+const (
+	roundUp      roundingMode = "up"
+	roundNearest roundingMode = "nearest"
+	roundDown    roundingMode = "down"
+)
+
+type rounding struct {
+	to   time.Duration
+	per  roundingUnit
+	mode roundingMode
+}
+
+func (r rounding) String() string {
+	if r.to == 0 {
+		return "none"
+	}
+
+	return fmt.Sprintf("%s to %s per %s", r.mode, r.to, r.per)
+}
+
+// apply rounds the duration to a multiple of the configured duration.
+func (r rounding) apply(d time.Duration) time.Duration {
+	if r.to == 0 {
+		return d
+	}
+
+	switch r.mode {
+	case roundUp:
+		rounded := d.Truncate(r.to)
+		if rounded < d {
+			rounded += r.to
+		}
+
+		return rounded
+	case roundNearest:
+		return d.Round(r.to)
+	case roundDown:
+		return d.Truncate(r.to)
+	}
+
+	return d
+}
+
+type grouping string
+
+const (
+	groupByDay        grouping = "day"
+	groupByAnnotation grouping = "annotation"
+)
+
+type config struct {
+	rates       map[string]rate
+	tax         decimal.Decimal
+	rounding    rounding
+	groupBy     grouping
+	format      output.Format
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name ...string) twext.ConfigKey {
+	return twext.NewConfigKey(append([]string{configKeyPrefix}, name...)...)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	rates, err := readRates(rawCfg)
+	if err != nil {
+		return config{}, fmt.Errorf("get rates: %w", err)
+	}
+
+	tax, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTax),
+		defaultTax,
+		parseTax,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get tax: %w", err)
+	}
+
+	roundTo, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyRoundTo),
+		defaultRoundTo,
+		parseRoundTo,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get rounding duration: %w", err)
+	}
+
+	roundPer, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyRoundPer),
+		defaultRoundPer,
+		optionParser(roundPerEntry, roundPerDay),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get rounding unit: %w", err)
+	}
+
+	roundingMode, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyRounding),
+		defaultRounding,
+		optionParser(roundUp, roundNearest, roundDown),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get rounding mode: %w", err)
+	}
+
+	groupBy, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyGroupBy),
+		defaultGroupBy,
+		optionParser(groupByDay, groupByAnnotation),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get grouping: %w", err)
+	}
+
+	format, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFormat),
+		defaultFormat,
+		output.FormatParser(
+			output.FormatText,
+			output.FormatMarkdown,
+			output.FormatJSON,
+		),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get format: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		rates: rates,
+		tax:   tax,
+		rounding: rounding{
+			to:   roundTo,
+			per:  roundPer,
+			mode: roundingMode,
+		},
+		groupBy:     groupBy,
+		format:      format,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	for _, tag := range slices.Sorted(maps.Keys(cfg.rates)) {
+		log.Printf("cfg - Rate: %s: %s", tag, cfg.rates[tag])
+	}
+
+	log.Println("cfg - Tax:", cfg.tax)
+	log.Println("cfg - Rounding:", cfg.rounding)
+	log.Println("cfg - GroupBy:", cfg.groupBy)
+	log.Println("cfg - Format:", cfg.format)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
+
+// readRates reads the hourly rates per client tag. Since tags may contain
+// dots, everything after the rate key prefix is taken as tag.
+func readRates(rawCfg twext.Config) (map[string]rate, error) {
+	rates := make(map[string]rate)
+	prefix := configKey(configKeyRate)
+
+	for key, value := range rawCfg {
+		tag, match := key.SubKey(prefix)
+		if !match || tag == "" {
+			continue
+		}
+
+		r, err := parseRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		rates[tag.String()] = r
+	}
+
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: set %s.<tag>", errNoRates, prefix)
+	}
+
+	return rates, nil
+}
+
+func parseRate(value twext.ConfigValue) (rate, error) {
+	fields := strings.Fields(value.String())
+	if len(fields) != rateFields {
+		return rate{}, fmt.Errorf("%w: %q", errInvalidRate, value)
+	}
+
+	amount, err := decimal.Parse(fields[0])
+	if err != nil {
+		return rate{}, fmt.Errorf("parse amount: %w", err)
+	}
+
+	if amount.Cmp(decimal.Decimal{}) < 0 {
+		return rate{}, fmt.Errorf("amount %w: %s", errNegative, amount)
+	}
+
+	return rate{amount: amount, currency: fields[1]}, nil
+}
+
+func parseTax(value twext.ConfigValue) (decimal.Decimal, error) {
+	tax, err := decimal.Parse(value.String())
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("parse percentage: %w", err)
+	}
+
+	if tax.Cmp(decimal.Decimal{}) < 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %s", errNegative, tax)
+	}
+
+	return tax, nil
+}
+
+func parseRoundTo(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegative, duration)
+	}
+
+	return duration, nil
+}
+
+func optionParser[T ~string](
+	options ...T,
+) func(twext.ConfigValue) (T, error) {
+	return func(value twext.ConfigValue) (T, error) {
+		option := T(value)
+		if !slices.Contains(options, option) {
+			return "", fmt.Errorf("%w: %s", errUnknownOption, value)
+		}
+
+		return option, nil
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    string
+		expectedErr error
+	}{
+		{input: "95.00 EUR", expected: "95.00 EUR"},
+		{input: " 80  USD ", expected: "80.00 USD"},
+		{input: "0 EUR", expected: "0.00 EUR"},
+		{input: "95.00", expectedErr: errInvalidRate},
+		{input: "95.00 EUR extra", expectedErr: errInvalidRate},
+		{input: "EUR 95.00", expectedErr: decimal.ErrInvalid},
+		{input: "-1 EUR", expectedErr: errNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parseRate(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expected, actual.String())
+		})
+	}
+}
+
+func TestReadRates(t *testing.T) {
+	rawCfg := twext.Config{
+		"invoice.rate":                 "1 EUR",
+		"invoice.rate.client:acme":     "95 EUR",
+		"invoice.rate.client.with.dot": "80 EUR",
+		"invoice.tax":                  "19",
+	}
+
+	rates, err := readRates(rawCfg)
+	require.NoError(t, err)
+
+	assert.Len(t, rates, 2)
+	assert.Contains(t, rates, "client:acme")
+	assert.Contains(t, rates, "client.with.dot")
+}
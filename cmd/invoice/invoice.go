@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	moneyPlaces       = 2
+	percentPlaces     = 2
+	noAnnotationLabel = "(no annotation)"
+)
+
+type lineItem struct {
+	description string
+	duration    time.Duration
+	amount      decimal.Decimal
+}
+
+type invoice struct {
+	client   string
+	rate     rate
+	taxRate  decimal.Decimal
+	items    []lineItem
+	duration time.Duration
+	subtotal decimal.Decimal
+	tax      decimal.Decimal
+	total    decimal.Decimal
+}
+
+// itemKey identifies the time of a client's line item on a single day.
+type itemKey struct {
+	client string
+	group  string
+	day    string
+}
+
+// dayKey identifies the time of a client on a single day.
+type dayKey struct {
+	client string
+	day    string
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func groupOf(entry twext.Entry, groupBy grouping) string {
+	switch groupBy {
+	case groupByDay:
+		return startDate(entry)
+	case groupByAnnotation:
+		if entry.Annotation == "" {
+			return noAnnotationLabel
+		}
+
+		return entry.Annotation
+	}
+
+	return ""
+}
+
+// collectDurations sums up the billable time per client, group and day.
+// Entries are clipped to the report range, so time outside of the invoiced
+// period is not billed. They are split at midnight, so rounding per entry
+// applies to each part of entries spanning multiple days.
+func collectDurations(
+	entries twext.Entries,
+	cfg config,
+) (map[itemKey]time.Duration, error) {
+	durations := make(map[itemKey]time.Duration)
+
+	for entry := range twext.SplitAtMidnight(twext.InLocation(
+		twext.ClipEntries(entries.All(), cfg.reportRange),
+		cfg.location,
+	)) {
+		client, err := groupkey.UniqueTag(entry, cfg.rates)
+		if err != nil {
+			return nil, fmt.Errorf("get client: %w", err)
+		}
+
+		if client == "" {
+			log.Printf("entry %d has no tag with rate. Skipping.", entry.ID)
+
+			continue
+		}
+
+		duration := entry.Duration()
+		if cfg.rounding.per == roundPerEntry {
+			duration = cfg.rounding.apply(duration)
+		}
+
+		key := itemKey{
+			client: client,
+			group:  groupOf(entry, cfg.groupBy),
+			day:    startDate(entry),
+		}
+		durations[key] += duration
+	}
+
+	return durations, nil
+}
+
+// roundDays rounds the time per client and day. The difference to the
+// unrounded time is distributed to the line items of the day, starting with
+// the longest one, so the rounding applies once per day, no matter how many
+// line items the time is spread across. Durations never become negative.
+func roundDays(
+	durations map[itemKey]time.Duration,
+	r rounding,
+) map[itemKey]time.Duration {
+	days := make(map[dayKey][]itemKey)
+	for key := range durations {
+		day := dayKey{client: key.client, day: key.day}
+		days[day] = append(days[day], key)
+	}
+
+	result := make(map[itemKey]time.Duration, len(durations))
+
+	for _, keys := range days {
+		slices.SortFunc(keys, func(a, b itemKey) int {
+			return cmp.Or(
+				cmp.Compare(durations[b], durations[a]),
+				cmp.Compare(a.group, b.group),
+			)
+		})
+
+		var total time.Duration
+		for _, key := range keys {
+			total += durations[key]
+		}
+
+		diff := r.apply(total) - total
+
+		for _, key := range keys {
+			adjust := max(diff, -durations[key])
+			result[key] = durations[key] + adjust
+			diff -= adjust
+		}
+	}
+
+	return result
+}
+
+// buildInvoices creates an invoice per client. Amounts of line items are
+// rounded to cents before they are summed up, so the line items add up to
+// the subtotal exactly.
+func buildInvoices(entries twext.Entries, cfg config) ([]invoice, error) {
+	durations, err := collectDurations(entries, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.rounding.per == roundPerDay {
+		durations = roundDays(durations, cfg.rounding)
+	}
+
+	groups := make(map[string]map[string]time.Duration)
+
+	for key, duration := range durations {
+		if groups[key.client] == nil {
+			groups[key.client] = make(map[string]time.Duration)
+		}
+
+		groups[key.client][key.group] += duration
+	}
+
+	percent := decimal.New(1, percentPlaces)
+	invoices := make([]invoice, 0, len(groups))
+
+	for _, client := range slices.Sorted(maps.Keys(groups)) {
+		inv := invoice{
+			client:  client,
+			rate:    cfg.rates[client],
+			taxRate: cfg.tax,
+		}
+
+		for _, group := range slices.Sorted(maps.Keys(groups[client])) {
+			item := lineItem{
+				description: group,
+				duration:    groups[client][group],
+			}
+			item.amount = inv.rate.amount.
+				Mul(decimal.Hours(item.duration)).
+				Round(moneyPlaces)
+
+			inv.items = append(inv.items, item)
+			inv.duration += item.duration
+			inv.subtotal = inv.subtotal.Add(item.amount)
+		}
+
+		inv.tax = inv.subtotal.Mul(cfg.tax).Mul(percent).Round(moneyPlaces)
+		inv.total = inv.subtotal.Add(inv.tax)
+		invoices = append(invoices, inv)
+	}
+
+	return invoices, nil
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRounding_Apply(t *testing.T) {
+	const d = 22*time.Minute + 30*time.Second
+
+	tests := []struct {
+		name     string
+		rounding rounding
+		input    time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "none",
+			rounding: rounding{mode: roundUp},
+			input:    d,
+			expected: d,
+		},
+		{
+			name:     "up",
+			rounding: rounding{to: 15 * time.Minute, mode: roundUp},
+			input:    d,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "up exact",
+			rounding: rounding{to: 15 * time.Minute, mode: roundUp},
+			input:    30 * time.Minute,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "nearest half",
+			rounding: rounding{to: 15 * time.Minute, mode: roundNearest},
+			input:    d,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:     "nearest",
+			rounding: rounding{to: 15 * time.Minute, mode: roundNearest},
+			input:    d - time.Second,
+			expected: 15 * time.Minute,
+		},
+		{
+			name:     "down",
+			rounding: rounding{to: 15 * time.Minute, mode: roundDown},
+			input:    d,
+			expected: 15 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.rounding.apply(tt.input))
+		})
+	}
+}
+
+func TestBuildInvoices_LineItemsAddUp(t *testing.T) {
+	start := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+
+	var entries twext.Entries
+
+	for day := range 3 {
+		entryStart := start.AddDate(0, 0, day)
+		entries = append(entries, twext.Entry{
+			Start: twext.Time{Time: entryStart},
+			End:   twext.Time{Time: entryStart.Add(20 * time.Minute)},
+			Tags:  []string{"acme"},
+		})
+	}
+
+	cfg := config{
+		rates: map[string]rate{
+			"acme": {amount: decimal.MustParse("95"), currency: "EUR"},
+		},
+		tax:      decimal.MustParse("19"),
+		groupBy:  groupByDay,
+		location: time.UTC,
+	}
+
+	invoices, err := buildInvoices(entries, cfg)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+
+	inv := invoices[0]
+	require.Len(t, inv.items, 3)
+
+	for _, item := range inv.items {
+		assert.Equal(t, "31.67", item.amount.Format(moneyPlaces))
+	}
+
+	assert.Equal(t, "95.01", inv.subtotal.Format(moneyPlaces))
+	assert.Equal(t, "18.05", inv.tax.Format(moneyPlaces))
+	assert.Equal(t, "113.06", inv.total.Format(moneyPlaces))
+}
+
+func TestBuildInvoices_RoundPerDay(t *testing.T) {
+	entry := func(hour int, annotation string) twext.Entry {
+		start := time.Date(2024, 7, 1, hour, 0, 0, 0, time.UTC)
+
+		return twext.Entry{
+			Start:      twext.Time{Time: start},
+			End:        twext.Time{Time: start.Add(20 * time.Minute)},
+			Tags:       []string{"acme"},
+			Annotation: annotation,
+		}
+	}
+
+	entries := twext.Entries{
+		entry(8, "review"),
+		entry(9, "meeting"),
+	}
+
+	cfg := config{
+		rates: map[string]rate{
+			"acme": {amount: decimal.MustParse("60"), currency: "EUR"},
+		},
+		rounding: rounding{
+			to:   15 * time.Minute,
+			per:  roundPerDay,
+			mode: roundUp,
+		},
+		groupBy:  groupByAnnotation,
+		location: time.UTC,
+	}
+
+	invoices, err := buildInvoices(entries, cfg)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+
+	expected := []lineItem{
+		{
+			description: "meeting",
+			duration:    25 * time.Minute,
+			amount:      decimal.MustParse("25.00"),
+		},
+		{
+			description: "review",
+			duration:    20 * time.Minute,
+			amount:      decimal.MustParse("20.00"),
+		},
+	}
+
+	inv := invoices[0]
+	require.Len(t, inv.items, len(expected))
+
+	for idx, item := range inv.items {
+		assert.Equal(t, expected[idx].description, item.description)
+		assert.Equal(t, expected[idx].duration, item.duration)
+		assert.Equal(t,
+			expected[idx].amount.Format(moneyPlaces),
+			item.amount.Format(moneyPlaces),
+		)
+	}
+
+	assert.Equal(t, 45*time.Minute, inv.duration)
+}
+
+func TestRoundDays(t *testing.T) {
+	key := func(group string) itemKey {
+		return itemKey{client: "acme", group: group, day: "2024-07-01"}
+	}
+
+	durations := map[itemKey]time.Duration{
+		key("a"): 5 * time.Minute,
+		key("b"): 7 * time.Minute,
+		key("c"): 5 * time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		mode     roundingMode
+		expected map[itemKey]time.Duration
+	}{
+		{
+			name: "up",
+			mode: roundUp,
+			expected: map[itemKey]time.Duration{
+				key("a"): 5 * time.Minute,
+				key("b"): 8 * time.Minute,
+				key("c"): 5 * time.Minute,
+			},
+		},
+		{
+			name: "down",
+			mode: roundDown,
+			expected: map[itemKey]time.Duration{
+				key("a"): 4 * time.Minute,
+				key("b"): 0,
+				key("c"): 5 * time.Minute,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rounding{to: 9 * time.Minute, per: roundPerDay, mode: tt.mode}
+			assert.Equal(t, tt.expected, roundDays(durations, r))
+		})
+	}
+}
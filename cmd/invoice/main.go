@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for creating invoices from the tracked
+// time with hourly rates per client.
+package main
+
+import (
+	"fmt"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	invoices, err := buildInvoices(entries, cfg)
+	if err != nil {
+		return fmt.Errorf("build invoices: %w", err)
+	}
+
+	switch cfg.format {
+	case output.FormatText:
+		printText(env.Out, invoices, cfg.groupBy)
+	case output.FormatMarkdown:
+		printMarkdown(env.Out, invoices, cfg.groupBy)
+	case output.FormatJSON:
+		return printJSON(env.Out, invoices, cfg.groupBy)
+	case output.FormatCSV:
+		return fmt.Errorf("%w: %s", output.ErrUnknownFormat, cfg.format)
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "invoice",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
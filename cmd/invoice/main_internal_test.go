@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("invoice.rate.client:acme", "95.00 EUR").
+		Config("invoice.rate.client:initech", "120 USD").
+		Config("invoice.tax", "19")
+}
+
+func addTestEntries(input *twexttest.Input) *twexttest.Input {
+	return input.
+		Entry(-52*time.Hour, -50*time.Hour-50*time.Minute, "client:acme").
+		Annotate("Setup | CI").
+		Entry(-50*time.Hour, -49*time.Hour, "client:acme", "meeting").
+		Entry(-28*time.Hour, -26*time.Hour+10*time.Minute, "client:acme").
+		Annotate("Setup | CI").
+		Entry(-26*time.Hour, -25*time.Hour, "client:initech").
+		Entry(-25*time.Hour, -24*time.Hour, "private")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedErr    error
+	}{
+		{
+			name:        "no rates",
+			input:       twexttest.NewInput(testNow).Config("verbose", "on"),
+			expectedErr: errNoRates,
+		},
+		{
+			name: "invalid rate",
+			input: twexttest.NewInput(testNow).
+				Config("invoice.rate.client:acme", "95.00"),
+			expectedErr: errInvalidRate,
+		},
+		{
+			name: "invalid amount",
+			input: twexttest.NewInput(testNow).
+				Config("invoice.rate.client:acme", "95,00 EUR"),
+			expectedErr: decimal.ErrInvalid,
+		},
+		{
+			name:        "negative tax",
+			input:       newTestInput().Config("invoice.tax", "-1"),
+			expectedErr: errNegative,
+		},
+		{
+			name:        "unknown rounding mode",
+			input:       newTestInput().Config("invoice.rounding", "sometimes"),
+			expectedErr: errUnknownOption,
+		},
+		{
+			name:        "unsupported format",
+			input:       newTestInput().Config("invoice.format", "csv"),
+			expectedErr: output.ErrUnknownFormat,
+		},
+		{
+			name: "ambiguous client",
+			input: newTestInput().
+				Entry(
+					-2*time.Hour,
+					-time.Hour,
+					"client:acme",
+					"client:initech",
+				),
+			expectedErr: groupkey.ErrAmbiguousTag,
+		},
+		{
+			name:           "no billable entries",
+			input:          newTestInput().Entry(-2*time.Hour, -time.Hour),
+			expectedStdout: "No billable entries.\n",
+		},
+		{
+			name: "text",
+			input: addTestEntries(newTestInput().
+				Config("invoice.round_to", "15m")),
+			expectedStdout: `
+Invoice for client:acme
+           day    hours         rate        amount
+    2024-06-29     2.25    95.00 EUR    213.75 EUR
+    2024-06-30     2.25    95.00 EUR    213.75 EUR
+      subtotal     4.50                 427.50 EUR
+     tax (19%)                           81.23 EUR
+         total                          508.73 EUR
+
+Invoice for client:initech
+           day    hours          rate        amount
+    2024-06-30     1.00    120.00 USD    120.00 USD
+      subtotal     1.00                  120.00 USD
+     tax (19%)                            22.80 USD
+         total                           142.80 USD
+`,
+		},
+		{
+			name: "markdown grouped by annotation",
+			input: addTestEntries(newTestInput().
+				Config("invoice.format", "markdown").
+				Config("invoice.group_by", "annotation").
+				Config("invoice.tax", "7.5")),
+			expectedStdout: `## Invoice for client:acme
+
+| annotation | hours | rate | amount |
+| --- | ---: | ---: | ---: |
+| (no annotation) | 1.00 | 95.00 EUR | 95.00 EUR |
+| Setup \| CI | 3.33 | 95.00 EUR | 316.67 EUR |
+| subtotal | 4.33 |  | 411.67 EUR |
+| tax (7.5%) |  |  | 30.88 EUR |
+| **total** |  |  | **442.55 EUR** |
+
+## Invoice for client:initech
+
+| annotation | hours | rate | amount |
+| --- | ---: | ---: | ---: |
+| (no annotation) | 1.00 | 120.00 USD | 120.00 USD |
+| subtotal | 1.00 |  | 120.00 USD |
+| tax (7.5%) |  |  | 9.00 USD |
+| **total** |  |  | **129.00 USD** |
+`,
+		},
+		{
+			name: "json rounded per day",
+			input: newTestInput().
+				Config("invoice.format", "json").
+				Config("invoice.rate.client:acme", "100 EUR").
+				Config("invoice.round_to", "30m").
+				Config("invoice.round_per", "day").
+				Config("invoice.rounding", "nearest").
+				Entry(-4*time.Hour, -3*time.Hour-50*time.Minute, "client:acme").
+				Entry(-3*time.Hour, -2*time.Hour-50*time.Minute, "client:acme"),
+			expectedStdout: `{
+  "group_by": "day",
+  "invoices": [
+    {
+      "client": "client:acme",
+      "currency": "EUR",
+      "rate": "100.00",
+      "tax_rate": "19",
+      "items": [
+        {
+          "description": "2024-07-01",
+          "seconds": 1800,
+          "hours": "0.50",
+          "amount": "50.00"
+        }
+      ],
+      "seconds": 1800,
+      "hours": "0.50",
+      "subtotal": "50.00",
+      "tax": "9.50",
+      "total": "59.50"
+    }
+  ]
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
+
+func TestRun_ReportRange(t *testing.T) {
+	input, err := os.ReadFile("testdata/input_report_range.txt")
+	require.NoError(t, err)
+
+	result := twexttest.Run(newReport().Run, string(input))
+	require.NoError(t, result.Err)
+
+	twexttest.AssertGolden(t, "run_report_range", result.Stdout)
+	assert.Empty(t, result.Stderr, "stderr")
+}
+
+func TestRun_Debug(t *testing.T) {
+	input := newTestInput().Config(twext.ConfigKeyDebug, "on")
+
+	result := twexttest.Run(newReport().Run, input.String())
+	require.NoError(t, result.Err)
+
+	assert.Contains(t, result.Stderr, "cfg - Rate: client:acme: 95.00 EUR\n")
+	assert.Contains(t, result.Stderr, "cfg - Rounding: none\n")
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const noBillableEntries = "No billable entries."
+
+func formatHours(d time.Duration) string {
+	return decimal.Hours(d).Format(moneyPlaces)
+}
+
+func formatMoney(amount decimal.Decimal, currency string) string {
+	return amount.Format(moneyPlaces) + " " + currency
+}
+
+func taxLabel(inv invoice) string {
+	return fmt.Sprintf("tax (%s%%)", inv.taxRate)
+}
+
+func printText(w io.Writer, invoices []invoice, groupBy grouping) {
+	table := output.NewTable(w)
+
+	if len(invoices) == 0 {
+		table.Line(noBillableEntries)
+	}
+
+	for _, inv := range invoices {
+		currency := inv.rate.currency
+
+		table.Line("")
+		table.Line("Invoice for " + inv.client)
+		table.Row(string(groupBy), "hours", "rate", "amount")
+
+		for _, item := range inv.items {
+			table.Row(
+				item.description,
+				formatHours(item.duration),
+				inv.rate.String(),
+				formatMoney(item.amount, currency),
+			)
+		}
+
+		table.Row(
+			"subtotal",
+			formatHours(inv.duration),
+			"",
+			formatMoney(inv.subtotal, currency),
+		)
+		table.Row(taxLabel(inv), "", "", formatMoney(inv.tax, currency))
+		table.Row("total", "", "", formatMoney(inv.total, currency))
+	}
+
+	table.Flush()
+}
+
+func printMarkdown(w io.Writer, invoices []invoice, groupBy grouping) {
+	if len(invoices) == 0 {
+		writeString(w, noBillableEntries+"\n")
+	}
+
+	for idx, inv := range invoices {
+		currency := inv.rate.currency
+
+		if idx > 0 {
+			writeString(w, "\n")
+		}
+
+		writeString(
+			w,
+			"## Invoice for "+output.MarkdownEscape(inv.client)+"\n\n",
+		)
+
+		table := output.NewMarkdownTable(
+			w,
+			string(groupBy),
+			"hours",
+			"rate",
+			"amount",
+		)
+
+		for _, item := range inv.items {
+			table.Row(
+				item.description,
+				formatHours(item.duration),
+				inv.rate.String(),
+				formatMoney(item.amount, currency),
+			)
+		}
+
+		table.Row(
+			"subtotal",
+			formatHours(inv.duration),
+			"",
+			formatMoney(inv.subtotal, currency),
+		)
+		table.Row(taxLabel(inv), "", "", formatMoney(inv.tax, currency))
+		table.Row(
+			"**total**",
+			"",
+			"",
+			"**"+formatMoney(inv.total, currency)+"**",
+		)
+	}
+}
+
+func writeString(w io.Writer, s string) {
+	_, err := io.WriteString(w, s)
+	if err != nil {
+		panic(fmt.Errorf("write: %w", err))
+	}
+}
+
+type jsonItem struct {
+	Description string `json:"description"`
+	Seconds     int64  `json:"seconds"`
+	Hours       string `json:"hours"`
+	Amount      string `json:"amount"`
+}
+
+type jsonInvoice struct {
+	Client   string     `json:"client"`
+	Currency string     `json:"currency"`
+	Rate     string     `json:"rate"`
+	TaxRate  string     `json:"tax_rate"`
+	Items    []jsonItem `json:"items"`
+	Seconds  int64      `json:"seconds"`
+	Hours    string     `json:"hours"`
+	Subtotal string     `json:"subtotal"`
+	Tax      string     `json:"tax"`
+	Total    string     `json:"total"`
+}
+
+type jsonDocument struct {
+	GroupBy  grouping      `json:"group_by"`
+	Invoices []jsonInvoice `json:"invoices"`
+}
+
+// printJSON writes all money amounts and hours as decimal strings, so they
+// can be consumed without loss of precision.
+func printJSON(w io.Writer, invoices []invoice, groupBy grouping) error {
+	doc := jsonDocument{
+		GroupBy:  groupBy,
+		Invoices: make([]jsonInvoice, 0, len(invoices)),
+	}
+
+	for _, inv := range invoices {
+		items := make([]jsonItem, 0, len(inv.items))
+		for _, item := range inv.items {
+			items = append(items, jsonItem{
+				Description: item.description,
+				Seconds:     int64(item.duration.Seconds()),
+				Hours:       formatHours(item.duration),
+				Amount:      item.amount.Format(moneyPlaces),
+			})
+		}
+
+		doc.Invoices = append(doc.Invoices, jsonInvoice{
+			Client:   inv.client,
+			Currency: inv.rate.currency,
+			Rate:     inv.rate.amount.Format(moneyPlaces),
+			TaxRate:  inv.taxRate.String(),
+			Items:    items,
+			Seconds:  int64(inv.duration.Seconds()),
+			Hours:    formatHours(inv.duration),
+			Subtotal: inv.subtotal.Format(moneyPlaces),
+			Tax:      inv.tax.Format(moneyPlaces),
+			Total:    inv.total.Format(moneyPlaces),
+		})
+	}
+
+	return output.WriteJSON(w, doc)
+}
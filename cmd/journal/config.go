@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "journal"
+	configKeyTemplate = "template"
+	defaultTemplate   = ""
+	configKeyMergeGap = "merge_gap"
+	defaultMergeGap   = "0s"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+var errNegativeMergeGap = errors.New("merge gap must not be negative")
+
+type config struct {
+	template    *template.Template
+	mergeGap    time.Duration
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseTemplate reads and parses the template file at the given path. If
+// the path is empty, the built-in template is used.
+func parseTemplate(value twext.ConfigValue) (*template.Template, error) {
+	name, text := builtinTemplateName, builtinTemplate
+
+	if value != "" {
+		name = value.String()
+
+		data, err := os.ReadFile(value.String())
+		if err != nil {
+			return nil, fmt.Errorf("read template: %w", err)
+		}
+
+		text = string(data)
+	}
+
+	tmpl, err := newTemplate(name, text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func parseMergeGap(value twext.ConfigValue) (time.Duration, error) {
+	gap, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if gap < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegativeMergeGap, gap)
+	}
+
+	return gap, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	tmpl, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTemplate),
+		defaultTemplate,
+		parseTemplate,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get template: %w", err)
+	}
+
+	mergeGap, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyMergeGap),
+		defaultMergeGap,
+		parseMergeGap,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get merge gap: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		template:    tmpl,
+		mergeGap:    mergeGap,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Template:", cfg.template.Name())
+	log.Println("cfg - MergeGap:", cfg.mergeGap)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// Block is a contiguous time span on the same tags. It is made of
+// consecutive entries with identical tags. Fields are exported for use in
+// templates.
+type Block struct {
+	Start       time.Time
+	End         time.Time
+	Duration    time.Duration
+	Tags        []string
+	Annotations []string
+	// Active is true if the last entry of the block is still active.
+	Active bool
+}
+
+// Day holds the blocks of a single day.
+type Day struct {
+	Date   time.Time
+	Blocks []Block
+	Total  time.Duration
+}
+
+// Journal is the data the template is executed with.
+type Journal struct {
+	Days  []Day
+	Total time.Duration
+}
+
+func compareStart(a, b twext.Entry) int {
+	return a.Start.Compare(b.Start.Time)
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func appendEntry(entries twext.Entries, entry twext.Entry) twext.Entries {
+	return append(entries, entry)
+}
+
+func sortedTags(entry twext.Entry) []string {
+	return slices.Compact(slices.Sorted(slices.Values(entry.Tags)))
+}
+
+// blocks merges consecutive entries with identical tags, if the gap between
+// them is not longer than the merge gap. Annotations of merged entries are
+// collected without duplicates.
+func blocks(entries twext.Entries, mergeGap time.Duration) []Block {
+	var result []Block
+
+	for _, entry := range slices.SortedStableFunc(entries.All(), compareStart) {
+		tags := sortedTags(entry)
+		end := entry.CurrentEnd().Time
+
+		if len(result) > 0 {
+			last := &result[len(result)-1]
+			if slices.Equal(last.Tags, tags) &&
+				entry.Start.Sub(last.End) <= mergeGap {
+				if end.After(last.End) {
+					last.End = end
+					last.Active = entry.IsActive()
+				}
+
+				last.Duration += entry.Duration()
+
+				if entry.Annotation != "" &&
+					!slices.Contains(last.Annotations, entry.Annotation) {
+					last.Annotations = append(
+						last.Annotations, entry.Annotation,
+					)
+				}
+
+				continue
+			}
+		}
+
+		block := Block{
+			Start:    entry.Start.Time,
+			End:      end,
+			Duration: entry.Duration(),
+			Tags:     tags,
+			Active:   entry.IsActive(),
+		}
+
+		if entry.Annotation != "" {
+			block.Annotations = []string{entry.Annotation}
+		}
+
+		result = append(result, block)
+	}
+
+	return result
+}
+
+// buildJournal groups the entries into days and blocks. Only the time within
+// the report range is listed. Entries are split at midnight, so each part is
+// listed on its own day.
+func buildJournal(entries twext.Entries, cfg config) Journal {
+	perDay := twext.Aggregate(
+		twext.SplitAtMidnight(twext.InLocation(
+			twext.ClipEntries(entries.All(), cfg.reportRange),
+			cfg.location,
+		)),
+		startDate,
+		appendEntry,
+	)
+
+	var journal Journal
+
+	for _, dayEntries := range perDay.Sorted() {
+		start := dayEntries[0].Start.Time
+		day := Day{
+			Date: time.Date(
+				start.Year(), start.Month(), start.Day(), 0, 0, 0, 0,
+				start.Location(),
+			),
+			Blocks: blocks(dayEntries, cfg.mergeGap),
+		}
+
+		for _, block := range day.Blocks {
+			day.Total += block.Duration
+		}
+
+		journal.Days = append(journal.Days, day)
+		journal.Total += day.Total
+	}
+
+	return journal
+}
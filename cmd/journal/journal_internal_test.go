@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocks(t *testing.T) {
+	clock := func(hour, minute int) time.Time {
+		return time.Date(2024, 7, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	entry := func(
+		start, end time.Time,
+		annotation string,
+		tags ...string,
+	) twext.Entry {
+		return twext.Entry{
+			Start:      twext.Time{Time: start},
+			End:        twext.Time{Time: end},
+			Tags:       tags,
+			Annotation: annotation,
+		}
+	}
+
+	entries := twext.Entries{
+		entry(clock(10, 0), clock(11, 0), "b", "y", "x"),
+		entry(clock(9, 0), clock(10, 0), "a", "x", "y"),
+		entry(clock(11, 0), clock(11, 30), "a", "x", "y"),
+		entry(clock(11, 40), clock(12, 0), "", "x", "y"),
+		entry(clock(12, 0), clock(13, 0), "", "z"),
+	}
+
+	expected := []Block{
+		{
+			Start:       clock(9, 0),
+			End:         clock(11, 30),
+			Duration:    150 * time.Minute,
+			Tags:        []string{"x", "y"},
+			Annotations: []string{"a", "b"},
+		},
+		{
+			Start:    clock(11, 40),
+			End:      clock(12, 0),
+			Duration: 20 * time.Minute,
+			Tags:     []string{"x", "y"},
+		},
+		{
+			Start:    clock(12, 0),
+			End:      clock(13, 0),
+			Duration: time.Hour,
+			Tags:     []string{"z"},
+		},
+	}
+
+	assert.Equal(t, expected, blocks(entries, 5*time.Minute))
+}
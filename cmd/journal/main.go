@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for rendering a Markdown journal.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	return renderJournal(env.Out, cfg.template, buildJournal(entries, cfg))
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "journal",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
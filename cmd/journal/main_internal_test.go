@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		EntryAt(at(7, 1, 9, 0), at(7, 1, 10, 30), "projA").
+		Annotate("Parser refactoring").
+		EntryAt(at(7, 1, 10, 30), at(7, 1, 12, 0), "projA").
+		Annotate("Parser refactoring").
+		EntryAt(at(7, 1, 12, 5), at(7, 1, 12, 30), "projA").
+		EntryAt(at(7, 1, 13, 0), at(7, 1, 14, 0), "team", "meeting").
+		Annotate("Sprint *planning*").
+		EntryAt(at(7, 1, 14, 0), at(7, 1, 17, 0), "projA").
+		Annotate("Review").
+		EntryAt(at(7, 1, 23, 0), at(7, 2, 1, 0), "release").
+		EntryAt(at(7, 2, 9, 0), time.Time{}, "projB")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "negative merge gap",
+			input:       newTestInput().Config("journal.merge_gap", "-5m"),
+			expectedErr: errNegativeMergeGap,
+		},
+		{
+			name: "missing template",
+			input: newTestInput().
+				Config("journal.template", "testdata/missing.tmpl"),
+			expectedErr: fs.ErrNotExist,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name:  "merge gap",
+			input: newTestInput().Config("journal.merge_gap", "5m"),
+		},
+		{
+			name: "custom template",
+			input: newTestInput().
+				Config("journal.template", "testdata/custom.tmpl"),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config("temp.report.start", "20240701T100000Z").
+				Config("temp.report.end", "20240702T000000Z"),
+		},
+		{
+			name:  "no entries",
+			input: twexttest.NewInput(testNow).Config("debug", "off"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const (
+	builtinTemplateName = "builtin"
+	clockFormat         = "15:04"
+	dayFormat           = "Monday, " + time.DateOnly
+)
+
+// builtinTemplate renders a heading per day with a bullet per block and the
+// total of the day.
+const builtinTemplate = `# Journal
+{{range .Days}}
+## {{day .Date}}
+
+{{range .Blocks -}}
+- {{clock .Start}}-{{if .Active}}now{{else}}{{clock .End}}{{end}}
+{{- with .Tags}} {{escape (join . ", ")}}{{end}}
+{{- with .Annotations}}: {{escape (join . "; ")}}{{end}}
+{{- " "}}({{duration .Duration}})
+{{end}}
+Total: {{duration .Total}}
+{{end}}
+**Total: {{duration .Total}}**
+`
+
+//nolint:gochecknoglobals
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+)
+
+// markdownInlineEscape escapes characters with special meaning in Markdown
+// inline text.
+func markdownInlineEscape(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func formatClock(t time.Time) string {
+	return t.Format(clockFormat)
+}
+
+func formatDay(t time.Time) string {
+	return t.Format(dayFormat)
+}
+
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// newTemplate parses the template text. Templates are executed with a
+// [Journal] and may use the following functions in addition to the builtin
+// ones:
+//
+//   - clock: formats a time like "09:30"
+//   - date: formats a time like "2024-07-01"
+//   - day: formats a time like "Monday, 2024-07-01"
+//   - duration: formats a duration like "7h:05m"
+//   - hours: formats a duration as decimal hours like "7.08"
+//   - join: joins a list of strings with a separator
+//   - escape: escapes Markdown special characters
+func newTemplate(name, text string) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"clock":    formatClock,
+		"date":     formatDate,
+		"day":      formatDay,
+		"duration": output.FormatDuration,
+		"hours":    output.FormatHours,
+		"join":     strings.Join,
+		"escape":   markdownInlineEscape,
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").
+		Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func renderJournal(w io.Writer, tmpl *template.Template, j Journal) error {
+	err := tmpl.Execute(w, j)
+	if err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+
+	return nil
+}
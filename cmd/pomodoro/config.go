@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix         = "pomodoro"
+	configKeyTag            = "tag"
+	defaultTag              = "pomodoro"
+	configKeyLength         = "length"
+	defaultLength           = "25m"
+	configKeyShortBreak     = "short_break"
+	defaultShortBreak       = "5m"
+	configKeyLongBreak      = "long_break"
+	defaultLongBreak        = "15m"
+	configKeyLongBreakAfter = "long_break_after"
+	defaultLongBreakAfter   = "4"
+	configKeyTolerance      = "break_tolerance"
+	defaultTolerance        = "2m"
+	configKeySessionGap     = "session_gap"
+	defaultSessionGap       = "30m"
+	configKeyTimezone       = "timezone"
+	defaultTimezone         = "local"
+)
+
+var (
+	errEmptyTag    = errors.New("tag must not be empty")
+	errNotPositive = errors.New("must be positive")
+	errNegative    = errors.New("duration must not be negative")
+)
+
+type config struct {
+	tag            string
+	length         time.Duration
+	shortBreak     time.Duration
+	longBreak      time.Duration
+	longBreakAfter int
+	tolerance      time.Duration
+	sessionGap     time.Duration
+	reportRange    twext.Interval
+	location       *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseTag(value twext.ConfigValue) (string, error) {
+	if value == "" {
+		return "", errEmptyTag
+	}
+
+	return value.String(), nil
+}
+
+func parsePositiveDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("%w: %s", errNotPositive, duration)
+	}
+
+	return duration, nil
+}
+
+func parseNonNegativeDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%w: %s", errNegative, duration)
+	}
+
+	return duration, nil
+}
+
+func parsePositiveInt(value twext.ConfigValue) (int, error) {
+	i, err := value.Int()
+	if err != nil {
+		return 0, fmt.Errorf("convert to int: %w", err)
+	}
+
+	if i <= 0 {
+		return 0, fmt.Errorf("%w: %d", errNotPositive, i)
+	}
+
+	return i, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	tag, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTag),
+		defaultTag,
+		parseTag,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get tag: %w", err)
+	}
+
+	length, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyLength),
+		defaultLength,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get length: %w", err)
+	}
+
+	shortBreak, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyShortBreak),
+		defaultShortBreak,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get short break: %w", err)
+	}
+
+	longBreak, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyLongBreak),
+		defaultLongBreak,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get long break: %w", err)
+	}
+
+	longBreakAfter, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyLongBreakAfter),
+		defaultLongBreakAfter,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get long break after: %w", err)
+	}
+
+	tolerance, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTolerance),
+		defaultTolerance,
+		parseNonNegativeDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get break tolerance: %w", err)
+	}
+
+	sessionGap, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeySessionGap),
+		defaultSessionGap,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get session gap: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		tag:            tag,
+		length:         length,
+		shortBreak:     shortBreak,
+		longBreak:      longBreak,
+		longBreakAfter: longBreakAfter,
+		tolerance:      tolerance,
+		sessionGap:     sessionGap,
+		reportRange:    reportRange,
+		location:       location,
+	}
+
+	log.Println("cfg - Tag:", cfg.tag)
+	log.Println("cfg - Length:", cfg.length)
+	log.Println("cfg - ShortBreak:", cfg.shortBreak)
+	log.Println("cfg - LongBreak:", cfg.longBreak)
+	log.Println("cfg - LongBreakAfter:", cfg.longBreakAfter)
+	log.Println("cfg - BreakTolerance:", cfg.tolerance)
+	log.Println("cfg - SessionGap:", cfg.sessionGap)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		EntryAt(at(7, 1, 9, 0), at(7, 1, 9, 25), "pomodoro", "projA").
+		EntryAt(at(7, 1, 9, 30), at(7, 1, 9, 55), "pomodoro", "projA").
+		EntryAt(at(7, 1, 10, 0), at(7, 1, 10, 25), "pomodoro", "projA").
+		EntryAt(at(7, 1, 10, 32), at(7, 1, 10, 57), "pomodoro", "projA").
+		EntryAt(at(7, 1, 11, 12), at(7, 1, 11, 37), "pomodoro", "projB").
+		EntryAt(at(7, 1, 11, 45), at(7, 1, 11, 55), "pomodoro", "projB").
+		EntryAt(at(7, 1, 11, 58), at(7, 1, 12, 23), "pomodoro", "projB").
+		EntryAt(at(7, 1, 12, 30), at(7, 1, 13, 30), "meeting").
+		EntryAt(at(7, 1, 14, 0), at(7, 1, 14, 25), "pomodoro").
+		EntryAt(at(7, 1, 14, 25), at(7, 1, 14, 50), "pomodoro").
+		EntryAt(at(7, 9, 9, 0), at(7, 9, 9, 25), "pomodoro").
+		EntryAt(at(7, 9, 9, 35), at(7, 9, 10, 0), "pomodoro").
+		EntryAt(at(7, 10, 11, 50), time.Time{}, "pomodoro")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "empty tag",
+			input:       newTestInput().Config("pomodoro.tag", ""),
+			expectedErr: errEmptyTag,
+		},
+		{
+			name:        "zero length",
+			input:       newTestInput().Config("pomodoro.length", "0m"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name: "negative tolerance",
+			input: newTestInput().
+				Config("pomodoro.break_tolerance", "-1m"),
+			expectedErr: errNegative,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "custom",
+			input: newTestInput().
+				Config("pomodoro.length", "20m").
+				Config("pomodoro.short_break", "10m").
+				Config("pomodoro.break_tolerance", "0s").
+				Config("pomodoro.session_gap", "2h"),
+		},
+		{
+			name: "report range",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				Config("temp.report.start", "20240701T000000Z").
+				EntryAt(at(6, 30, 23, 50), at(7, 1, 0, 15), "pomodoro").
+				EntryAt(at(7, 1, 9, 0), at(7, 1, 9, 25), "pomodoro").
+				EntryAt(at(7, 1, 9, 30), at(7, 1, 9, 55), "pomodoro"),
+		},
+		{
+			name: "no pomodoros",
+			input: twexttest.NewInput(testNow).
+				Config("debug", "off").
+				EntryAt(at(7, 1, 9, 0), at(7, 1, 10, 0), "meeting"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
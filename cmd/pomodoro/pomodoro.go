@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const percent = 100
+
+// stats are the pomodoro metrics of a period like a day or a week.
+type stats struct {
+	sessions    int
+	completed   int
+	interrupted int
+	breaks      int
+	// adherent is the number of breaks that match the expected break length
+	// within the tolerance.
+	adherent  int
+	breakTime time.Duration
+}
+
+// add adds the metrics of another period.
+func (s stats) add(o stats) stats {
+	return stats{
+		sessions:    s.sessions + o.sessions,
+		completed:   s.completed + o.completed,
+		interrupted: s.interrupted + o.interrupted,
+		breaks:      s.breaks + o.breaks,
+		adherent:    s.adherent + o.adherent,
+		breakTime:   s.breakTime + o.breakTime,
+	}
+}
+
+// averageBreak returns the average duration of the breaks.
+func (s stats) averageBreak() time.Duration {
+	if s.breaks == 0 {
+		return 0
+	}
+
+	return s.breakTime / time.Duration(s.breaks)
+}
+
+// adherence returns the share of breaks that match the expected break length
+// in percent.
+func (s stats) adherence() float64 {
+	if s.breaks == 0 {
+		return 0
+	}
+
+	return float64(s.adherent) / float64(s.breaks) * percent
+}
+
+// period holds the metrics of a named period, like a date or a week.
+type period struct {
+	name string
+	stats
+}
+
+func compareStart(a, b twext.Entry) int {
+	return a.Start.Compare(b.Start.Time)
+}
+
+// pomodoros returns the finished entries with the pomodoro tag clipped to the
+// report range and sorted by start time. Active entries are still running and
+// neither completed nor interrupted yet. They are filtered out before
+// clipping, which would end them at the end of the report range.
+func pomodoros(entries twext.Entries, cfg config) []twext.Entry {
+	isPomodoro := func(entry twext.Entry) bool {
+		return !entry.IsActive() && slices.Contains(entry.Tags, cfg.tag)
+	}
+
+	return slices.SortedStableFunc(
+		twext.InLocation(
+			twext.ClipEntries(
+				twext.EntryFilter(isPomodoro).Filter(entries.All()),
+				cfg.reportRange,
+			),
+			cfg.location,
+		),
+		compareStart,
+	)
+}
+
+// expectedBreak returns the break length expected after the given pomodoro.
+// A long break is expected after every configured number of completed
+// pomodoros in the current session, if the last one was completed, too.
+func expectedBreak(
+	previous twext.Entry,
+	completed int,
+	cfg config,
+) time.Duration {
+	if previous.Duration() >= cfg.length && completed%cfg.longBreakAfter == 0 {
+		return cfg.longBreak
+	}
+
+	return cfg.shortBreak
+}
+
+// analyze calculates the metrics per day and per ISO week. A session is a
+// series of pomodoros with breaks not longer than the session gap. Breaks
+// within a session are accounted for the day of the following pomodoro.
+func analyze(entries twext.Entries, cfg config) ([]period, []period) {
+	var (
+		days, weeks []period
+		dayWeeks    []string
+		previous    *twext.Entry
+		completed   int
+	)
+
+	for _, entry := range pomodoros(entries, cfg) {
+		date := entry.Start.Format(time.DateOnly)
+		if len(days) == 0 || days[len(days)-1].name != date {
+			days = append(days, period{name: date})
+			dayWeeks = append(dayWeeks, groupkey.ISOWeek(entry.Start.Time))
+		}
+
+		day := &days[len(days)-1]
+
+		var gap time.Duration
+		if previous != nil {
+			gap = twext.Interval{
+				Start: previous.End.Time,
+				End:   entry.Start.Time,
+			}.Duration()
+		}
+
+		switch {
+		case previous == nil, gap > cfg.sessionGap:
+			day.sessions++
+			completed = 0
+		default:
+			expected := expectedBreak(*previous, completed, cfg)
+
+			day.breaks++
+			day.breakTime += gap
+
+			if (gap - expected).Abs() <= cfg.tolerance {
+				day.adherent++
+			}
+		}
+
+		if entry.Duration() >= cfg.length {
+			day.completed++
+			completed++
+		} else {
+			day.interrupted++
+		}
+
+		previous = &entry
+	}
+
+	for idx, day := range days {
+		week := dayWeeks[idx]
+		if len(weeks) == 0 || weeks[len(weeks)-1].name != week {
+			weeks = append(weeks, period{name: week})
+		}
+
+		last := &weeks[len(weeks)-1]
+		last.stats = last.stats.add(day.stats)
+	}
+
+	return days, weeks
+}
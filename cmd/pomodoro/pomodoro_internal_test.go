@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpectedBreak(t *testing.T) {
+	cfg := config{
+		length:         25 * time.Minute,
+		shortBreak:     5 * time.Minute,
+		longBreak:      15 * time.Minute,
+		longBreakAfter: 4,
+	}
+
+	entry := func(length time.Duration) twext.Entry {
+		start := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+
+		return twext.Entry{
+			Start: twext.Time{Time: start},
+			End:   twext.Time{Time: start.Add(length)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		previous  twext.Entry
+		completed int
+		expected  time.Duration
+	}{
+		{
+			name:      "short",
+			previous:  entry(25 * time.Minute),
+			completed: 3,
+			expected:  5 * time.Minute,
+		},
+		{
+			name:      "long",
+			previous:  entry(25 * time.Minute),
+			completed: 4,
+			expected:  15 * time.Minute,
+		},
+		{
+			name:      "long again",
+			previous:  entry(30 * time.Minute),
+			completed: 8,
+			expected:  15 * time.Minute,
+		},
+		{
+			name:      "after interrupted",
+			previous:  entry(10 * time.Minute),
+			completed: 4,
+			expected:  5 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := expectedBreak(tt.previous, tt.completed, cfg)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
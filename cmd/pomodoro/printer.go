@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+func formatPercentage(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+func row(label string, s stats) []string {
+	return []string{
+		label,
+		strconv.Itoa(s.sessions),
+		strconv.Itoa(s.completed),
+		strconv.Itoa(s.interrupted),
+		strconv.Itoa(s.breaks),
+		output.FormatDuration(s.averageBreak()),
+		formatPercentage(s.adherence()),
+	}
+}
+
+func header(label string) []string {
+	return []string{
+		label,
+		"sessions",
+		"completed",
+		"interrupted",
+		"breaks",
+		"avg break",
+		"adherence",
+	}
+}
+
+func printPomodoros(w io.Writer, days, weeks []period) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(days) == 0 {
+		table.Line("No pomodoros.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row(header("date")...)
+
+	for _, day := range days {
+		table.Row(row(day.name, day.stats)...)
+	}
+
+	table.Line("")
+	table.Row(header("week")...)
+
+	for _, week := range weeks {
+		table.Row(row(week.name, week.stats)...)
+	}
+
+	table.Flush()
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/timetarget"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix              = "promexport"
+	configKeyDirectory           = "directory"
+	defaultDirectory             = ""
+	configKeyFilename            = "filename"
+	defaultFilename              = "timewarrior.prom"
+	configKeyTimezone            = "timezone"
+	defaultTimezone              = "local"
+	configKeyOffsetTotal         = "offset_total"
+	defaultOffsetTotal           = "0"
+	configKeyAggregationStrategy = "aggregation_strategy"
+	defaultAggregationStrategy   = "single-day-only"
+)
+
+var errInvalidFilename = errors.New("filename must be a plain file name")
+
+type config struct {
+	// directory is the directory the metrics file is written to. Metrics are
+	// written to stdout if it is empty.
+	directory   string
+	filename    string
+	timeTargets timetarget.Targets
+	offset      time.Duration
+	// strategy and flextimeLocation are the flextime aggregation strategy
+	// and time zone, used for the flextime balance.
+	strategy         *timetarget.AggregationStrategy[string, time.Duration]
+	flextimeLocation *time.Location
+	reportRange      twext.Interval
+	location         *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseString(value twext.ConfigValue) (string, error) {
+	return value.String(), nil
+}
+
+func parseFilename(value twext.ConfigValue) (string, error) {
+	name := value.String()
+	if name == "" || name != filepath.Base(name) || name == ".." {
+		return "", fmt.Errorf("%w: %q", errInvalidFilename, name)
+	}
+
+	return name, nil
+}
+
+func parseDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	return duration, nil
+}
+
+func parseAggregationStrategy(
+	value twext.ConfigValue,
+) (*timetarget.AggregationStrategy[string, time.Duration], error) {
+	strategy, err := timetarget.NewAggregationStrategy(value.String())
+	if err != nil {
+		return nil, fmt.Errorf("create aggregation strategy: %w", err)
+	}
+
+	return strategy, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	directory, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyDirectory),
+		defaultDirectory,
+		parseString,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get directory: %w", err)
+	}
+
+	filename, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFilename),
+		defaultFilename,
+		parseFilename,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get filename: %w", err)
+	}
+
+	targets, err := timetarget.Read(rawCfg)
+	if err != nil {
+		return config{}, fmt.Errorf("get time target: %w", err)
+	}
+
+	// The offset, the aggregation strategy and the time zone are shared with
+	// the flextime extension, so the exported balance matches its total.
+	offset, err := twext.ReadConfigValue(
+		rawCfg,
+		twext.NewConfigKey(timetarget.ConfigKeyPrefix, configKeyOffsetTotal),
+		defaultOffsetTotal,
+		parseDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get total offset: %w", err)
+	}
+
+	strategy, err := twext.ReadConfigValue(
+		rawCfg,
+		twext.NewConfigKey(
+			timetarget.ConfigKeyPrefix,
+			configKeyAggregationStrategy,
+		),
+		defaultAggregationStrategy,
+		parseAggregationStrategy,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get aggregation strategy: %w", err)
+	}
+
+	flextimeLocation, err := twext.ReadConfigValue(
+		rawCfg,
+		twext.NewConfigKey(timetarget.ConfigKeyPrefix, configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get flextime timezone: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		directory:        directory,
+		filename:         filename,
+		timeTargets:      targets,
+		offset:           offset,
+		strategy:         strategy,
+		flextimeLocation: flextimeLocation,
+		reportRange:      reportRange,
+		location:         location,
+	}
+
+	log.Println("cfg - Directory:", cfg.directory)
+	log.Println("cfg - Filename:", cfg.filename)
+	log.Println("cfg - Target:", cfg.timeTargets)
+	log.Println("cfg - Offset:", cfg.offset)
+	log.Println("cfg - AggregationStrategy:", cfg.strategy)
+	log.Println("cfg - FlextimeTimezone:", cfg.flextimeLocation)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for exporting metrics in the Prometheus
+// text exposition format.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const fileMode = 0o644
+
+// writeFile writes the data into the file in the given directory. The data is
+// written to a temporary file first, which is then renamed, so a concurrent
+// reader like the node exporter never sees a partial file. The temporary file
+// is removed on failure.
+func writeFile(dir, name string, data []byte) (err error) {
+	tmp, err := os.CreateTemp(dir, "."+name+".*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	err = tmp.Chmod(fileMode)
+	if err != nil {
+		return fmt.Errorf("chmod: %w", err)
+	}
+
+	err = tmp.Sync()
+	if err != nil {
+		return fmt.Errorf("sync: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("close: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), filepath.Join(dir, name))
+	if err != nil {
+		return fmt.Errorf("rename: %w", err)
+	}
+
+	return nil
+}
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	metrics, err := collect(entries, cfg)
+	if err != nil {
+		return fmt.Errorf("collect metrics: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	printMetrics(&buf, metrics)
+
+	if cfg.directory == "" {
+		_, err = buf.WriteTo(env.Out)
+		if err != nil {
+			return fmt.Errorf("write: %w", err)
+		}
+
+		return nil
+	}
+
+	err = writeFile(cfg.directory, cfg.filename, buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("write metrics file: %w", err)
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "promexport",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
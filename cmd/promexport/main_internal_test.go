@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/timetarget"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config("debug", "off").
+		Config("flextime.time_per_day", "8h").
+		EntryAt(at(7, 1, 9, 0), at(7, 1, 12, 0), "projA").
+		EntryAt(at(7, 1, 13, 0), at(7, 1, 14, 0), "team", "meeting").
+		EntryAt(at(7, 1, 14, 0), at(7, 1, 17, 30), "projA").
+		EntryAt(at(7, 1, 23, 0), at(7, 2, 1, 0)).
+		EntryAt(at(7, 2, 9, 0), time.Time{}, `say "hi"\now`)
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name: "invalid filename",
+			input: newTestInput().
+				Config("promexport.filename", "sub/timewarrior.prom"),
+			expectedErr: errInvalidFilename,
+		},
+		{
+			name: "missing directory",
+			input: newTestInput().
+				Config("promexport.directory", "testdata/missing"),
+			expectedErr: fs.ErrNotExist,
+		},
+		{
+			name: "unknown aggregation strategy",
+			input: newTestInput().
+				Config("flextime.aggregation_strategy", "foo"),
+			expectedErr: timetarget.ErrUnknownAggregationStrategy,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name:  "offset",
+			input: newTestInput().Config("flextime.offset_total", "-2h30m"),
+		},
+		{
+			name: "timezone",
+			input: newTestInput().
+				Config("promexport.timezone", "Europe/Berlin"),
+		},
+		{
+			name: "aggregation strategy",
+			input: newTestInput().
+				Config("flextime.aggregation_strategy", "split-at-midnight"),
+		},
+		{
+			name: "flextime timezone",
+			input: newTestInput().
+				Config("flextime.timezone", "Europe/Berlin"),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config("temp.report.start", "20240701T100000Z"),
+		},
+		{
+			name:  "no entries",
+			input: twexttest.NewInput(testNow).Config("debug", "off"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
+
+func TestRun_Directory(t *testing.T) {
+	twexttest.FixedClock(t, testNow)
+
+	dir := t.TempDir()
+	input := newTestInput().
+		Config("promexport.directory", twext.ConfigValue(dir)).
+		Config("promexport.filename", "tw.prom")
+
+	result := twexttest.Run(newReport().Run, input.String())
+	require.NoError(t, result.Err)
+	assert.Empty(t, result.Stdout, "stdout")
+
+	data, err := os.ReadFile(filepath.Join(dir, "tw.prom"))
+	require.NoError(t, err)
+	twexttest.AssertGolden(t, "run_default", string(data))
+
+	info, err := os.Stat(filepath.Join(dir, "tw.prom"))
+	require.NoError(t, err)
+	assert.Equal(t, fs.FileMode(fileMode), info.Mode().Perm())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "temporary files left")
+}
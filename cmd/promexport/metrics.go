@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const untaggedLabel = "(untagged)"
+
+// label is a single label name and value pair of a sample.
+type label struct {
+	name  string
+	value string
+}
+
+// sample is a single value of a metric.
+type sample struct {
+	labels []label
+	value  float64
+}
+
+// metric is a metric family with all its samples. All metrics are exported as
+// gauges.
+type metric struct {
+	name    string
+	help    string
+	samples []sample
+}
+
+func entryTags(entry twext.Entry) []string {
+	if len(entry.Tags) == 0 {
+		return []string{untaggedLabel}
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(entry.Tags)))
+}
+
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// trackedSeconds sums up the tracked time per day and tag within the report
+// range. Entries are split at midnight and entries with multiple tags are
+// accounted for each of their tags.
+func trackedSeconds(entries twext.Entries, cfg config) metric {
+	perDay := make(map[time.Time]map[string]time.Duration)
+
+	for entry := range twext.SplitAtMidnight(twext.InLocation(
+		twext.ClipEntries(entries.All(), cfg.reportRange),
+		cfg.location,
+	)) {
+		date := dateOf(entry.Start.Time)
+		if perDay[date] == nil {
+			perDay[date] = make(map[string]time.Duration)
+		}
+
+		for _, tag := range entryTags(entry) {
+			perDay[date][tag] += entry.Duration()
+		}
+	}
+
+	result := metric{
+		name: "timewarrior_tracked_seconds",
+		help: "Tracked time per day and tag.",
+	}
+
+	dates := slices.SortedFunc(maps.Keys(perDay), time.Time.Compare)
+	for _, date := range dates {
+		for _, tag := range slices.Sorted(maps.Keys(perDay[date])) {
+			result.samples = append(result.samples, sample{
+				labels: []label{
+					{name: "date", value: date.Format(time.DateOnly)},
+					{name: "tag", value: tag},
+				},
+				value: perDay[date][tag].Seconds(),
+			})
+		}
+	}
+
+	return result
+}
+
+// flextimeBalance calculates the flextime balance as the total offset plus
+// the difference between the tracked time and the time target of each day
+// with tracked time. The days are aggregated with the aggregation strategy
+// and time zone of the flextime extension, so the balance matches its total.
+func flextimeBalance(entries twext.Entries, cfg config) (metric, error) {
+	perDay := cfg.strategy.Aggregate(
+		twext.InLocation(entries.All(), cfg.flextimeLocation),
+	)
+
+	balance := cfg.offset
+
+	for day, duration := range perDay {
+		date, err := time.Parse(time.DateOnly, day)
+		if err != nil {
+			return metric{}, fmt.Errorf("parse date: %w", err)
+		}
+
+		balance += duration - cfg.timeTargets.For(date)
+	}
+
+	return metric{
+		name: "timewarrior_flextime_balance_seconds",
+		help: "Flextime balance including the configured total offset.",
+		samples: []sample{
+			{value: balance.Seconds()},
+		},
+	}, nil
+}
+
+// activeInterval returns the duration of the active entry, or 0 if no entry
+// is active.
+func activeInterval(entries twext.Entries) metric {
+	var duration time.Duration
+
+	for _, entry := range entries {
+		if entry.IsActive() {
+			duration += entry.Duration()
+		}
+	}
+
+	return metric{
+		name: "timewarrior_active_interval_seconds",
+		help: "Duration of the active interval, 0 if none is active.",
+		samples: []sample{
+			{value: duration.Seconds()},
+		},
+	}
+}
+
+func entryCount(entries twext.Entries) metric {
+	return metric{
+		name: "timewarrior_entries",
+		help: "Number of entries in the report range.",
+		samples: []sample{
+			{value: float64(len(entries))},
+		},
+	}
+}
+
+// collect gathers all exported metrics in a stable order.
+func collect(entries twext.Entries, cfg config) ([]metric, error) {
+	balance, err := flextimeBalance(entries, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("flextime balance: %w", err)
+	}
+
+	return []metric{
+		trackedSeconds(entries, cfg),
+		balance,
+		activeInterval(entries),
+		entryCount(entries),
+	}, nil
+}
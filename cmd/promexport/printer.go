@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+//nolint:gochecknoglobals
+var (
+	helpEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"\n", `\n`,
+	)
+	labelValueEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+	)
+)
+
+func fprintf(w io.Writer, format string, a ...any) {
+	_, err := fmt.Fprintf(w, format, a...)
+	if err != nil {
+		panic(fmt.Errorf("fprintf: %w", err))
+	}
+}
+
+func formatLabels(labels []label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		value := labelValueEscaper.Replace(l.value)
+		pairs = append(pairs, l.name+`="`+value+`"`)
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// printMetrics writes the metrics in the Prometheus text exposition format.
+func printMetrics(w io.Writer, metrics []metric) {
+	for _, m := range metrics {
+		fprintf(w, "# HELP %s %s\n", m.name, helpEscaper.Replace(m.help))
+		fprintf(w, "# TYPE %s gauge\n", m.name)
+
+		for _, s := range m.samples {
+			fprintf(w, "%s%s %s\n",
+				m.name, formatLabels(s.labels), formatValue(s.value))
+		}
+	}
+}
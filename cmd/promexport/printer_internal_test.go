@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   []label
+		expected string
+	}{
+		{
+			name:     "none",
+			expected: "",
+		},
+		{
+			name: "plain",
+			labels: []label{
+				{name: "date", value: "2024-07-01"},
+				{name: "tag", value: "projA"},
+			},
+			expected: `{date="2024-07-01",tag="projA"}`,
+		},
+		{
+			name:     "quote",
+			labels:   []label{{name: "tag", value: `say "hi"`}},
+			expected: `{tag="say \"hi\""}`,
+		},
+		{
+			name:     "backslash",
+			labels:   []label{{name: "tag", value: `C:\tmp`}},
+			expected: `{tag="C:\\tmp"}`,
+		},
+		{
+			name:     "newline",
+			labels:   []label{{name: "tag", value: "a\nb"}},
+			expected: `{tag="a\nb"}`,
+		},
+		{
+			name:     "escaped newline",
+			labels:   []label{{name: "tag", value: `a\nb`}},
+			expected: `{tag="a\\nb"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatLabels(tt.labels))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "streaks"
+	configKeyHabit    = "habit"
+	configKeyWeeks    = "weeks"
+	defaultWeeks      = "4"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+var (
+	errNoHabits        = errors.New("no habits configured")
+	errInvalidHabit    = errors.New("invalid habit")
+	errNotPositive     = errors.New("must be positive")
+	errUnknownSchedule = errors.New("unknown schedule")
+)
+
+// schedule defines the days a habit is due.
+type schedule string
+
+const (
+	// scheduleDaily habits are due every day.
+	scheduleDaily schedule = "daily"
+	// scheduleWeekdays habits are due from Monday to Friday.
+	scheduleWeekdays schedule = "weekdays"
+)
+
+// includes returns true if the habit is due on the given day.
+func (s schedule) includes(day time.Time) bool {
+	switch s {
+	case scheduleDaily:
+		return true
+	case scheduleWeekdays:
+		return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+	}
+
+	return false
+}
+
+// habit is a tag that should be tracked for a minimum duration on each day
+// it is due.
+type habit struct {
+	tag      string
+	minimum  time.Duration
+	schedule schedule
+}
+
+type config struct {
+	habits      []habit
+	weeks       int
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+// parseHabit parses a habit like "30m" or, with schedule, "30m weekdays".
+func parseHabit(value twext.ConfigValue) (time.Duration, schedule, error) {
+	const (
+		withoutSchedule = 1
+		withSchedule    = 2
+	)
+
+	fields := strings.Fields(value.String())
+	if len(fields) != withoutSchedule && len(fields) != withSchedule {
+		return 0, "", fmt.Errorf(
+			"%w: expected duration and optional schedule: %s",
+			errInvalidHabit,
+			value,
+		)
+	}
+
+	minimum, err := time.ParseDuration(fields[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %w", errInvalidHabit, err)
+	}
+
+	if minimum <= 0 {
+		return 0, "", fmt.Errorf("%w: duration %s", errNotPositive, minimum)
+	}
+
+	s := scheduleDaily
+	if len(fields) == withSchedule {
+		s = schedule(fields[1])
+		if !slices.Contains([]schedule{scheduleDaily, scheduleWeekdays}, s) {
+			return 0, "", fmt.Errorf("%w: %s", errUnknownSchedule, s)
+		}
+	}
+
+	return minimum, s, nil
+}
+
+func readHabits(rawCfg twext.Config) ([]habit, error) {
+	var habits []habit
+
+	prefix := configKey(configKeyHabit)
+
+	for key, value := range rawCfg {
+		tag, match := key.SubKey(prefix)
+		if !match || tag == "" {
+			continue
+		}
+
+		minimum, s, err := parseHabit(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		habits = append(habits, habit{
+			tag:      tag.String(),
+			minimum:  minimum,
+			schedule: s,
+		})
+	}
+
+	if len(habits) == 0 {
+		return nil, fmt.Errorf("%w: set %s.<tag>", errNoHabits, prefix)
+	}
+
+	slices.SortFunc(habits, func(a, b habit) int {
+		return cmp.Compare(a.tag, b.tag)
+	})
+
+	return habits, nil
+}
+
+func parsePositiveInt(value twext.ConfigValue) (int, error) {
+	i, err := value.Int()
+	if err != nil {
+		return 0, fmt.Errorf("convert to int: %w", err)
+	}
+
+	if i <= 0 {
+		return 0, fmt.Errorf("%w: %d", errNotPositive, i)
+	}
+
+	return i, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	habits, err := readHabits(rawCfg)
+	if err != nil {
+		return config{}, fmt.Errorf("get habits: %w", err)
+	}
+
+	weeks, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyWeeks),
+		defaultWeeks,
+		parsePositiveInt,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get weeks: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		habits:      habits,
+		weeks:       weeks,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Habits:", len(cfg.habits))
+	log.Println("cfg - Weeks:", cfg.weeks)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
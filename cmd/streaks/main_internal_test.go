@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(month time.Month, day, hour, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	input := twexttest.NewInput(testNow).
+		Config("streaks.habit.reading", "30m").
+		Config("streaks.habit.exercise", "20m weekdays")
+
+	// Reading every day from June 25th to July 10th, except for a short
+	// session on July 4th.
+	for day := 25; day <= 30; day++ {
+		input.EntryAt(at(6, day, 21, 0), at(6, day, 21, 45), "reading")
+	}
+
+	for day := 1; day <= 10; day++ {
+		end := at(7, day, 21, 45)
+		if day == 4 {
+			end = at(7, day, 21, 20)
+		}
+
+		input.EntryAt(at(7, day, 21, 0), end, "reading")
+	}
+
+	// Exercise on weekdays only, not yet today.
+	for _, day := range []int{1, 2, 3, 4, 5, 8, 9} {
+		input.EntryAt(at(7, day, 7, 0), at(7, day, 7, 30), "exercise")
+	}
+
+	return input.EntryAt(at(7, 6, 10, 0), at(7, 6, 11, 0), "exercise")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "no habits",
+			input:       twexttest.NewInput(testNow).Config("debug", "off"),
+			expectedErr: errNoHabits,
+		},
+		{
+			name: "invalid habit",
+			input: newTestInput().
+				Config("streaks.habit.music", "30m 2024-01-01 daily"),
+			expectedErr: errInvalidHabit,
+		},
+		{
+			name: "unknown schedule",
+			input: newTestInput().
+				Config("streaks.habit.music", "30m weekends"),
+			expectedErr: errUnknownSchedule,
+		},
+		{
+			name:        "zero weeks",
+			input:       newTestInput().Config("streaks.weeks", "0"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name:  "default",
+			input: newTestInput(),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config("temp.report.start", "20240628T213000Z"),
+		},
+		{
+			name: "weeks",
+			input: newTestInput().
+				Config("streaks.weeks", "2").
+				Config("streaks.habit.music", "1h"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
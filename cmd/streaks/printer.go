@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const (
+	weekdayInitials = "MTWTFSS"
+	weekSeparator   = " "
+	legend          = "█ reached  · missed"
+)
+
+// stateSymbols are the calendar strip symbols per day state.
+//
+//nolint:gochecknoglobals
+var stateSymbols = map[dayState]string{
+	stateReached: "█",
+	stateMissed:  "·",
+	stateSkipped: " ",
+	stateFuture:  " ",
+}
+
+// stripHeader returns the weekday initials for the given number of weeks.
+func stripHeader(weeks int) string {
+	initials := make([]string, weeks)
+	for idx := range initials {
+		initials[idx] = weekdayInitials
+	}
+
+	return strings.Join(initials, weekSeparator)
+}
+
+// formatStrip formats the day states as calendar strip with a separator
+// between the weeks.
+func formatStrip(states []dayState) string {
+	var strip strings.Builder
+
+	for idx, state := range states {
+		if idx > 0 && idx%daysPerWeek == 0 {
+			strip.WriteString(weekSeparator)
+		}
+
+		strip.WriteString(stateSymbols[state])
+	}
+
+	return strip.String()
+}
+
+func printStreaks(w io.Writer, results []result, weeks int) {
+	table := output.NewTable(w)
+	table.Line("")
+	table.Row(
+		"tag",
+		"minimum",
+		"schedule",
+		"current",
+		"longest",
+		stripHeader(weeks),
+	)
+
+	for _, r := range results {
+		table.Row(
+			r.tag,
+			output.FormatDuration(r.minimum),
+			string(r.schedule),
+			strconv.Itoa(r.current),
+			strconv.Itoa(r.longest),
+			formatStrip(r.strip),
+		)
+	}
+
+	table.Line("")
+	table.Line(legend)
+	table.Flush()
+}
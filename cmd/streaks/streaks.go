@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const daysPerWeek = 7
+
+// dayState is the state of a habit on a single day.
+type dayState int
+
+const (
+	// stateReached days have at least the minimum duration tracked.
+	stateReached dayState = iota
+	// stateMissed days are due, but have less than the minimum tracked.
+	stateMissed
+	// stateSkipped days are not due.
+	stateSkipped
+	// stateFuture days are after today.
+	stateFuture
+)
+
+// result holds the streaks of a single habit and its states for the days
+// of the calendar strip.
+type result struct {
+	habit
+	current int
+	longest int
+	strip   []dayState
+}
+
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// weekStart returns the Monday of the week the given day is in.
+func weekStart(day time.Time) time.Time {
+	weekday := (int(day.Weekday()) + daysPerWeek - 1) % daysPerWeek
+
+	return day.AddDate(0, 0, -weekday)
+}
+
+// state returns the state of the habit on the given day.
+func (h habit) state(
+	day time.Time,
+	today time.Time,
+	durations map[string]time.Duration,
+) dayState {
+	switch {
+	case day.After(today):
+		return stateFuture
+	case durations[day.Format(time.DateOnly)] >= h.minimum:
+		return stateReached
+	case !h.schedule.includes(day):
+		return stateSkipped
+	default:
+		return stateMissed
+	}
+}
+
+// streaks returns the current and the longest streak of consecutive due
+// days on which the habit is reached. Days that are not due neither extend
+// nor break a streak, even if the habit is reached on them. Today only
+// extends the current streak, so it does not break before the day is over.
+func (h habit) streaks(
+	first time.Time,
+	today time.Time,
+	durations map[string]time.Duration,
+) (int, int) {
+	var current, longest int
+
+	for day := first; day.Before(today); day = day.AddDate(0, 0, 1) {
+		if !h.schedule.includes(day) {
+			continue
+		}
+
+		switch h.state(day, today, durations) {
+		case stateReached:
+			current++
+			longest = max(longest, current)
+		case stateMissed:
+			current = 0
+		case stateSkipped, stateFuture:
+		}
+	}
+
+	if h.schedule.includes(today) &&
+		h.state(today, today, durations) == stateReached {
+		current++
+		longest = max(longest, current)
+	}
+
+	return current, longest
+}
+
+// calculate returns the streaks and the calendar strip of all habits. The
+// strip covers the configured number of weeks up to the current one. Only
+// the time within the report range is taken into account, so time before it
+// neither reaches a habit nor extends a streak.
+func calculate(entries twext.Entries, cfg config) []result {
+	today := midnight(twext.Now().In(cfg.location))
+	stripStart := weekStart(today).AddDate(0, 0, -(cfg.weeks-1)*daysPerWeek)
+	localEntries := slices.Collect(twext.SplitAtMidnight(twext.InLocation(
+		twext.ClipEntries(entries.All(), cfg.reportRange),
+		cfg.location,
+	)))
+
+	results := make([]result, 0, len(cfg.habits))
+
+	for _, h := range cfg.habits {
+		hasTag := func(entry twext.Entry) bool {
+			return slices.Contains(entry.Tags, h.tag)
+		}
+
+		durations := make(map[string]time.Duration)
+
+		var first time.Time
+
+		filter := twext.EntryFilter(hasTag).Filter
+		for entry := range filter(slices.Values(localEntries)) {
+			day := midnight(entry.Start.Time)
+			durations[day.Format(time.DateOnly)] += entry.Duration()
+
+			if first.IsZero() || day.Before(first) {
+				first = day
+			}
+		}
+
+		r := result{habit: h}
+
+		if !first.IsZero() {
+			r.current, r.longest = h.streaks(first, today, durations)
+		}
+
+		for idx := range cfg.weeks * daysPerWeek {
+			day := stripStart.AddDate(0, 0, idx)
+			r.strip = append(r.strip, h.state(day, today, durations))
+		}
+
+		results = append(results, r)
+	}
+
+	return results
+}
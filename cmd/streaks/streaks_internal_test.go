@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHabit_Streaks(t *testing.T) {
+	// Monday July 1st to Wednesday July 10th.
+	first := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC)
+
+	durations := func(dates ...string) map[string]time.Duration {
+		result := make(map[string]time.Duration)
+		for _, date := range dates {
+			result["2024-07-"+date] = time.Hour
+		}
+
+		return result
+	}
+
+	tests := []struct {
+		name            string
+		schedule        schedule
+		durations       map[string]time.Duration
+		expectedCurrent int
+		expectedLongest int
+	}{
+		{
+			name:            "broken",
+			schedule:        scheduleDaily,
+			durations:       durations("01", "02", "03", "05", "06"),
+			expectedLongest: 3,
+		},
+		{
+			name:            "today pending",
+			schedule:        scheduleDaily,
+			durations:       durations("07", "08", "09"),
+			expectedCurrent: 3,
+			expectedLongest: 3,
+		},
+		{
+			name:            "today reached",
+			schedule:        scheduleDaily,
+			durations:       durations("08", "09", "10"),
+			expectedCurrent: 3,
+			expectedLongest: 3,
+		},
+		{
+			name:            "weekend skipped",
+			schedule:        scheduleWeekdays,
+			durations:       durations("04", "05", "08", "09"),
+			expectedCurrent: 4,
+			expectedLongest: 4,
+		},
+		{
+			name:            "weekend not counted",
+			schedule:        scheduleWeekdays,
+			durations:       durations("05", "06", "07", "08"),
+			expectedLongest: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := habit{minimum: time.Hour, schedule: tt.schedule}
+
+			current, longest := h.streaks(first, today, tt.durations)
+			assert.Equal(t, tt.expectedCurrent, current, "current")
+			assert.Equal(t, tt.expectedLongest, longest, "longest")
+		})
+	}
+}
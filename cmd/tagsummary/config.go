@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix    = "tagsummary"
+	configKeyFormat    = "format"
+	defaultFormat      = "text"
+	configKeyPerDay    = "per_day"
+	configKeyDepth     = "depth"
+	defaultDepth       = "0"
+	configKeySeparator = "separator"
+	defaultSeparator   = ":"
+	configKeyTimezone  = "timezone"
+	defaultTimezone    = "local"
+)
+
+var errNegativeDepth = errors.New("depth must not be negative")
+
+type config struct {
+	format      output.Format
+	perDay      bool
+	depth       int
+	separator   string
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	format, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFormat),
+		defaultFormat,
+		output.FormatParser(
+			output.FormatText,
+			output.FormatCSV,
+			output.FormatJSON,
+		),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get format: %w", err)
+	}
+
+	depth, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyDepth),
+		defaultDepth,
+		parseDepth,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get depth: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	separator, exists := rawCfg[configKey(configKeySeparator)]
+	if !exists {
+		separator = defaultSeparator
+	}
+
+	cfg := config{
+		format:      format,
+		perDay:      rawCfg[configKey(configKeyPerDay)].Bool(),
+		depth:       depth,
+		separator:   separator.String(),
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Format:", cfg.format)
+	log.Println("cfg - PerDay:", cfg.perDay)
+	log.Println("cfg - Depth:", cfg.depth)
+	log.Println("cfg - Separator:", cfg.separator)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
+
+func parseDepth(value twext.ConfigValue) (int, error) {
+	depth, err := value.Int()
+	if err != nil {
+		return 0, fmt.Errorf("convert to int: %w", err)
+	}
+
+	if depth < 0 {
+		return 0, fmt.Errorf("%w: %d", errNegativeDepth, depth)
+	}
+
+	return depth, nil
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for summing up the tracked time per tag.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	summary := summarize(entries, cfg)
+
+	//nolint:exhaustive // Config parsing rejects unsupported formats.
+	switch cfg.format {
+	case output.FormatText:
+		printText(env.Out, summary, cfg.perDay)
+	case output.FormatCSV:
+		return printCSV(env.Out, summary, cfg.perDay)
+	case output.FormatJSON:
+		return printJSON(env.Out, summary, cfg.perDay)
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "tagsummary",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
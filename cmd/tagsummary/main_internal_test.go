@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+const testEntries = `[
+{"id":5,"start":"20240629T080000Z","end":"20240629T110000Z",
+ "tags":["projA:dev"]},
+{"id":4,"start":"20240629T120000Z","end":"20240629T130000Z",
+ "tags":["projA:dev","meeting"]},
+{"id":3,"start":"20240629T130000Z","end":"20240629T133000Z"},
+{"id":2,"start":"20240630T080000Z","end":"20240630T100000Z",
+ "tags":["projA:review"]},
+{"id":1,"start":"20240630T223000Z","end":"20240701T003000Z",
+ "tags":["projB"]}
+]`
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedStdout string
+		expectedErr    error
+	}{
+		{
+			name:        "empty input",
+			expectedErr: twext.ErrConfigEmpty,
+		},
+		{
+			name:        "invalid format",
+			input:       "tagsummary.format: xml",
+			expectedErr: output.ErrUnknownFormat,
+		},
+		{
+			name:        "negative depth",
+			input:       "tagsummary.depth: -1",
+			expectedErr: errNegativeDepth,
+		},
+		{
+			name:  "no entries",
+			input: "verbose: on\n\n[]",
+			expectedStdout: `
+      tag     total       %
+    total    0h:00m    0.0%
+`,
+		},
+		{
+			name:  "default",
+			input: "verbose: on\n\n" + testEntries,
+			expectedStdout: `
+             tag     total         %
+       projA:dev    4h:00m     47.1%
+    projA:review    2h:00m     23.5%
+           projB    2h:00m     23.5%
+         meeting    1h:00m     11.8%
+      (untagged)    0h:30m      5.9%
+           total    8h:30m    100.0%
+`,
+		},
+		{
+			name: "depth and per day",
+			input: `verbose: on
+tagsummary.depth: 1
+tagsummary.per_day: on
+
+` + testEntries,
+			expectedStdout: `
+           tag     total         %    2024-06-29    2024-06-30    2024-07-01
+         projA    6h:00m     70.6%        4h:00m        2h:00m        0h:00m
+         projB    2h:00m     23.5%        0h:00m        1h:30m        0h:30m
+       meeting    1h:00m     11.8%        1h:00m        0h:00m        0h:00m
+    (untagged)    0h:30m      5.9%        0h:30m        0h:00m        0h:00m
+         total    8h:30m    100.0%        4h:30m        3h:30m        0h:30m
+`,
+		},
+		{
+			name: "timezone",
+			input: `tagsummary.per_day: on
+tagsummary.depth: 1
+tagsummary.timezone: Europe/Berlin
+
+` + testEntries,
+			expectedStdout: `
+           tag     total         %    2024-06-29    2024-06-30    2024-07-01
+         projA    6h:00m     70.6%        4h:00m        2h:00m        0h:00m
+         projB    2h:00m     23.5%        0h:00m        0h:00m        2h:00m
+       meeting    1h:00m     11.8%        1h:00m        0h:00m        0h:00m
+    (untagged)    0h:30m      5.9%        0h:30m        0h:00m        0h:00m
+         total    8h:30m    100.0%        4h:30m        2h:00m        2h:00m
+`,
+		},
+		{
+			name: "report range",
+			input: `tagsummary.per_day: on
+tagsummary.depth: 1
+temp.report.start: 20240629T120000Z
+temp.report.end: 20240701T000000Z
+
+` + testEntries,
+			expectedStdout: `
+           tag     total         %    2024-06-29    2024-06-30
+         projA    3h:00m     60.0%        1h:00m        2h:00m
+         projB    1h:30m     30.0%        0h:00m        1h:30m
+       meeting    1h:00m     20.0%        1h:00m        0h:00m
+    (untagged)    0h:30m     10.0%        0h:30m        0h:00m
+         total    5h:00m    100.0%        1h:30m        3h:30m
+`,
+		},
+		{
+			name: "csv",
+			input: `tagsummary.format: csv
+tagsummary.depth: 1
+tagsummary.per_day: on
+
+` + testEntries,
+			expectedStdout: "tag,hours,percentage," +
+				"2024-06-29,2024-06-30,2024-07-01\n" +
+				`projA,6.00,70.6,4.00,2.00,0.00
+projB,2.00,23.5,0.00,1.50,0.50
+meeting,1.00,11.8,1.00,0.00,0.00
+(untagged),0.50,5.9,0.50,0.00,0.00
+total,8.50,100.0,4.50,3.50,0.50
+`,
+		},
+		{
+			name: "json",
+			input: `tagsummary.format: json
+tagsummary.depth: 1
+
+` + testEntries,
+			expectedStdout: `{
+  "seconds": 30600,
+  "tags": [
+    {
+      "tag": "projA",
+      "seconds": 21600,
+      "percentage": 70.59
+    },
+    {
+      "tag": "projB",
+      "seconds": 7200,
+      "percentage": 23.53
+    },
+    {
+      "tag": "meeting",
+      "seconds": 3600,
+      "percentage": 11.76
+    },
+    {
+      "tag": "(untagged)",
+      "seconds": 1800,
+      "percentage": 5.88
+    }
+  ]
+}
+`,
+		},
+		{
+			name: "json per day",
+			input: `tagsummary.format: json
+tagsummary.per_day: on
+
+[
+{"id":1,"start":"20240630T223000Z","end":"20240701T003000Z","tags":["projB"]}
+]`,
+			expectedStdout: `{
+  "seconds": 7200,
+  "days": {
+    "2024-06-30": 5400,
+    "2024-07-01": 1800
+  },
+  "tags": [
+    {
+      "tag": "projB",
+      "seconds": 7200,
+      "percentage": 100,
+      "days": {
+        "2024-06-30": 5400,
+        "2024-07-01": 1800
+      }
+    }
+  ]
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := twexttest.Run(newReport().Run, tt.input)
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
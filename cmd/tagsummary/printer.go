@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+func formatPercentage(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+func printText(w io.Writer, s summary, perDay bool) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	header := []string{"tag", "total", "%"}
+	if perDay {
+		header = append(header, s.days...)
+	}
+
+	table.Row(header...)
+
+	for _, row := range s.rows() {
+		columns := []string{
+			row.tag,
+			output.FormatDuration(row.total),
+			formatPercentage(s.percentage(row.total)),
+		}
+
+		if perDay {
+			for _, day := range s.days {
+				columns = append(columns, output.FormatDuration(row.days[day]))
+			}
+		}
+
+		table.Row(columns...)
+	}
+
+	table.Flush()
+}
+
+func printCSV(w io.Writer, s summary, perDay bool) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"tag", "hours", "percentage"}
+	if perDay {
+		header = append(header, s.days...)
+	}
+
+	records := [][]string{header}
+
+	for _, row := range s.rows() {
+		record := []string{
+			row.tag,
+			output.FormatHours(row.total),
+			strconv.FormatFloat(s.percentage(row.total), 'f', 1, 64),
+		}
+
+		if perDay {
+			for _, day := range s.days {
+				record = append(record, output.FormatHours(row.days[day]))
+			}
+		}
+
+		records = append(records, record)
+	}
+
+	err := writer.WriteAll(records)
+	if err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+
+	return nil
+}
+
+type jsonTag struct {
+	Tag        string           `json:"tag"`
+	Seconds    int64            `json:"seconds"`
+	Percentage float64          `json:"percentage"`
+	Days       map[string]int64 `json:"days,omitempty"`
+}
+
+type jsonSummary struct {
+	Seconds int64            `json:"seconds"`
+	Days    map[string]int64 `json:"days,omitempty"`
+	Tags    []jsonTag        `json:"tags"`
+}
+
+func jsonSeconds(d time.Duration) int64 {
+	return int64(d.Seconds())
+}
+
+func jsonDays(days map[string]time.Duration, perDay bool) map[string]int64 {
+	if !perDay {
+		return nil
+	}
+
+	result := make(map[string]int64, len(days))
+	for day, duration := range days {
+		result[day] = jsonSeconds(duration)
+	}
+
+	return result
+}
+
+func printJSON(w io.Writer, s summary, perDay bool) error {
+	const precision = 100
+
+	doc := jsonSummary{
+		Seconds: jsonSeconds(s.total),
+		Days:    jsonDays(s.dayTotals, perDay),
+		Tags:    make([]jsonTag, 0, len(s.tags)),
+	}
+
+	for _, tag := range s.tags {
+		doc.Tags = append(doc.Tags, jsonTag{
+			Tag:     tag.tag,
+			Seconds: jsonSeconds(tag.total),
+			Percentage: math.Round(
+				s.percentage(tag.total)*precision,
+			) / precision,
+			Days: jsonDays(tag.days, perDay),
+		})
+	}
+
+	return output.WriteJSON(w, doc)
+}
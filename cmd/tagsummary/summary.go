@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	untaggedLabel = "(untagged)"
+	totalLabel    = "total"
+	percent       = 100
+)
+
+type tagDayKey = twext.KeyPair[string, string]
+
+type tagSum struct {
+	tag   string
+	total time.Duration
+	days  map[string]time.Duration
+}
+
+type summary struct {
+	total     time.Duration
+	days      []string
+	dayTotals map[string]time.Duration
+	tags      []tagSum
+}
+
+// rows returns the tag sums followed by the total sum.
+func (s summary) rows() []tagSum {
+	return append(slices.Clone(s.tags), tagSum{
+		tag:   totalLabel,
+		total: s.total,
+		days:  s.dayTotals,
+	})
+}
+
+func (s summary) percentage(d time.Duration) float64 {
+	if s.total == 0 {
+		return 0
+	}
+
+	return float64(d) / float64(s.total) * percent
+}
+
+// truncateTag cuts the hierarchical tag after the given number of levels. A
+// depth of 0 keeps the tag as is.
+func truncateTag(tag, separator string, depth int) string {
+	if depth == 0 || separator == "" {
+		return tag
+	}
+
+	levels := strings.SplitN(tag, separator, depth+1)
+	if len(levels) <= depth {
+		return tag
+	}
+
+	return strings.Join(levels[:depth], separator)
+}
+
+// entryTags returns the distinct truncated tags of the entry. Untagged
+// entries get a placeholder tag so they are accounted as well.
+func entryTags(entry twext.Entry, separator string, depth int) []string {
+	if len(entry.Tags) == 0 {
+		return []string{untaggedLabel}
+	}
+
+	tags := make([]string, 0, len(entry.Tags))
+	for _, tag := range entry.Tags {
+		tags = append(tags, truncateTag(tag, separator, depth))
+	}
+
+	slices.Sort(tags)
+
+	return slices.Compact(tags)
+}
+
+func startDate(entry twext.Entry) string {
+	return entry.Start.Format(time.DateOnly)
+}
+
+func sumDuration(result time.Duration, entry twext.Entry) time.Duration {
+	return result + entry.Duration()
+}
+
+func add(a, b time.Duration) time.Duration {
+	return a + b
+}
+
+// summarize sums up the tracked time per tag and per day. Entries are clipped
+// to the report range and entries spanning multiple days are split at
+// midnight in the configured time zone. Entries with multiple tags count for
+// each of their tags.
+func summarize(entries twext.Entries, cfg config) summary {
+	pieces := twext.Entries(slices.Collect(twext.SplitAtMidnight(
+		twext.InLocation(
+			twext.ClipEntries(entries.All(), cfg.reportRange),
+			cfg.location,
+		),
+	)))
+
+	dayTotals := twext.Aggregate(pieces.All(), startDate, sumDuration)
+
+	tagDays := twext.AggregateFanOut(
+		pieces.All(),
+		func(entry twext.Entry) []tagDayKey {
+			tags := entryTags(entry, cfg.separator, cfg.depth)
+			keys := make([]tagDayKey, 0, len(tags))
+
+			for _, tag := range tags {
+				keys = append(keys, tagDayKey{
+					First:  tag,
+					Second: startDate(entry),
+				})
+			}
+
+			return keys
+		},
+		sumDuration,
+	)
+
+	table := twext.Pivot(tagDays, tagDayKey.Split)
+	tagTotals := table.RowTotals(add)
+
+	result := summary{
+		days:      dayTotals.SortedKeys(),
+		dayTotals: dayTotals,
+		tags:      make([]tagSum, 0, len(table)),
+	}
+
+	for _, dayTotal := range dayTotals {
+		result.total += dayTotal
+	}
+
+	for tag, days := range table {
+		result.tags = append(result.tags, tagSum{
+			tag:   tag,
+			total: tagTotals[tag],
+			days:  days,
+		})
+	}
+
+	slices.SortFunc(result.tags, func(a, b tagSum) int {
+		return cmp.Or(
+			cmp.Compare(b.total, a.total),
+			cmp.Compare(a.tag, b.tag),
+		)
+	})
+
+	return result
+}
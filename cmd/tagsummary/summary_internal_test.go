@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateTag(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		separator string
+		depth     int
+		expected  string
+	}{
+		{
+			name:      "depth 0",
+			tag:       "a:b:c",
+			separator: ":",
+			expected:  "a:b:c",
+		},
+		{
+			name:      "depth 1",
+			tag:       "a:b:c",
+			separator: ":",
+			depth:     1,
+			expected:  "a",
+		},
+		{
+			name:      "depth 2",
+			tag:       "a:b:c",
+			separator: ":",
+			depth:     2,
+			expected:  "a:b",
+		},
+		{
+			name:      "depth exceeds levels",
+			tag:       "a:b",
+			separator: ":",
+			depth:     3,
+			expected:  "a:b",
+		},
+		{
+			name:      "other separator",
+			tag:       "a/b:c",
+			separator: "/",
+			depth:     1,
+			expected:  "a",
+		},
+		{
+			name:     "empty separator",
+			tag:      "a:b",
+			depth:    1,
+			expected: "a:b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := truncateTag(tt.tag, tt.separator, tt.depth)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestEntryTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		depth    int
+		expected []string
+	}{
+		{
+			name:     "untagged",
+			expected: []string{untaggedLabel},
+		},
+		{
+			name:     "sorted",
+			tags:     []string{"b", "a"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:     "deduplicated after truncation",
+			tags:     []string{"a:x", "b", "a:y"},
+			depth:    1,
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := twext.Entry{Tags: tt.tags}
+			actual := entryTags(entry, ":", tt.depth)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
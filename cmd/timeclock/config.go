@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "timeclock"
+	configKeyFormat   = "format"
+	defaultFormat     = "timeclock"
+	configKeyAccount  = "account"
+	defaultAccount    = "{1}"
+	configKeyMissing  = "missing"
+	defaultMissing    = "unknown"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+// format is the plain text accounting format entries are exported in.
+type format string
+
+const (
+	// formatTimeclock prints a clock-in and a clock-out record per entry.
+	formatTimeclock format = "timeclock"
+	// formatTimedot prints the hours per account and day.
+	formatTimedot format = "timedot"
+)
+
+func parseFormat(value twext.ConfigValue) (format, error) {
+	f := format(value)
+	if !slices.Contains([]format{formatTimeclock, formatTimedot}, f) {
+		return "", fmt.Errorf("%w: %s", output.ErrUnknownFormat, value)
+	}
+
+	return f, nil
+}
+
+type config struct {
+	format      format
+	account     accountTemplate
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name ...string) twext.ConfigKey {
+	return twext.NewConfigKey(append([]string{configKeyPrefix}, name...)...)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	f, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFormat),
+		defaultFormat,
+		parseFormat,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get format: %w", err)
+	}
+
+	account, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyAccount),
+		defaultAccount,
+		parseAccountTemplate,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get account: %w", err)
+	}
+
+	account.missing, err = twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyAccount, configKeyMissing),
+		defaultMissing,
+		parseAccountName,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get account for missing tags: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		format:      f,
+		account:     account,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Format:", cfg.format)
+	log.Println("cfg - Account:", cfg.account)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
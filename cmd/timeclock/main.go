@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for exporting entries in the timeclock
+// and timedot formats of the plain text accounting tools hledger and ledger.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	switch cfg.format {
+	case formatTimeclock:
+		printTimeclock(env.Out, buildRecords(entries, cfg))
+	case formatTimedot:
+		warnOpenEntries(env.Err, entries)
+		printTimedot(env.Out, buildDots(entries, cfg))
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "timeclock",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
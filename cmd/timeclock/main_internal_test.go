@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+// newTestInput returns entries deliberately not in chronological order.
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 13, 0), at(1, 14, 15), "acme", "meeting").
+		Annotate("weekly  sync").
+		EntryAt(at(1, 9, 0), at(1, 12, 30), "acme", "dev").
+		EntryAt(at(1, 22, 0), at(2, 1, 0), "initech").
+		Annotate("deployment").
+		EntryAt(at(2, 9, 0), at(2, 10, 0)).
+		EntryAt(at(2, 11, 0), time.Time{}, "acme", "dev")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedStderr string
+		expectedErr    error
+	}{
+		{
+			name:        "unknown format",
+			input:       newTestInput().Config("timeclock.format", "csv"),
+			expectedErr: output.ErrUnknownFormat,
+		},
+		{
+			name:        "invalid template",
+			input:       newTestInput().Config("timeclock.account", "a:{0}"),
+			expectedErr: errInvalidTemplate,
+		},
+		{
+			name: "empty missing account",
+			input: newTestInput().
+				Config("timeclock.account.missing", " "),
+			expectedErr: errEmptyAccount,
+		},
+		{
+			name:  "timeclock",
+			input: newTestInput(),
+			expectedStdout: `i 2024-07-01 09:00:00 acme
+o 2024-07-01 12:30:00
+i 2024-07-01 13:00:00 acme  weekly sync
+o 2024-07-01 14:15:00
+i 2024-07-01 22:00:00 initech  deployment
+o 2024-07-02 01:00:00
+i 2024-07-02 09:00:00 unknown
+o 2024-07-02 10:00:00
+i 2024-07-02 11:00:00 acme
+`,
+		},
+		{
+			name: "account template",
+			input: newTestInput().
+				Config("timeclock.account", "client:{1}:{2}").
+				Config("timeclock.account.missing", "misc"),
+			expectedStdout: `i 2024-07-01 09:00:00 client:acme:dev
+o 2024-07-01 12:30:00
+i 2024-07-01 13:00:00 client:acme:meeting  weekly sync
+o 2024-07-01 14:15:00
+i 2024-07-01 22:00:00 client:initech:misc  deployment
+o 2024-07-02 01:00:00
+i 2024-07-02 09:00:00 client:misc:misc
+o 2024-07-02 10:00:00
+i 2024-07-02 11:00:00 client:acme:dev
+`,
+		},
+		{
+			name: "timedot",
+			input: newTestInput().
+				Config("timeclock.format", "timedot").
+				Config("timeclock.account", "client:{1}:{2}"),
+			expectedStdout: `2024-07-01
+client:acme:dev  3.50
+client:acme:meeting  1.25
+client:initech:unknown  2.00
+
+2024-07-02
+client:initech:unknown  1.00
+client:unknown:unknown  1.00
+`,
+			expectedStderr: "warning: skipping open entry @1, " +
+				"timedot has no open records\n",
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240702T000000Z"),
+			expectedStdout: `i 2024-07-02 00:00:00 initech  deployment
+o 2024-07-02 01:00:00
+i 2024-07-02 09:00:00 unknown
+o 2024-07-02 10:00:00
+i 2024-07-02 11:00:00 acme
+`,
+		},
+		{
+			name: "timedot report range",
+			input: newTestInput().
+				Config("timeclock.format", "timedot").
+				Config("timeclock.account", "client:{1}:{2}").
+				Config(twext.ConfigKeyReportStart, "20240702T000000Z"),
+			expectedStdout: `2024-07-02
+client:initech:unknown  1.00
+client:unknown:unknown  1.00
+`,
+			expectedStderr: "warning: skipping open entry @1, " +
+				"timedot has no open records\n",
+		},
+		{
+			name: "timezone",
+			input: newTestInput().
+				Config("timeclock.timezone", "Europe/Berlin"),
+			expectedStdout: `i 2024-07-01 11:00:00 acme
+o 2024-07-01 14:30:00
+i 2024-07-01 15:00:00 acme  weekly sync
+o 2024-07-01 16:15:00
+i 2024-07-02 00:00:00 initech  deployment
+o 2024-07-02 03:00:00
+i 2024-07-02 11:00:00 unknown
+o 2024-07-02 12:00:00
+i 2024-07-02 13:00:00 acme
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Equal(t, tt.expectedStderr, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const timeclockFormat = "2006-01-02 15:04:05"
+
+func writeLine(w io.Writer, a ...any) {
+	_, err := fmt.Fprintln(w, a...)
+	if err != nil {
+		panic(fmt.Errorf("fprintln: %w", err))
+	}
+}
+
+// printTimeclock prints a clock-in and a clock-out line per record. Open
+// records have a clock-in line only. The annotation is used as description.
+func printTimeclock(w io.Writer, records []record) {
+	for _, r := range records {
+		line := "i " + r.start.Format(timeclockFormat) + " " + r.account
+		if r.description != "" {
+			line += "  " + r.description
+		}
+
+		writeLine(w, line)
+
+		if !r.end.IsZero() {
+			writeLine(w, "o "+r.end.Format(timeclockFormat))
+		}
+	}
+}
+
+// warnOpenEntries warns about each open entry, as they are skipped in the
+// timedot output.
+func warnOpenEntries(w io.Writer, entries twext.Entries) {
+	for _, entry := range entries {
+		if !entry.IsActive() {
+			continue
+		}
+
+		_, err := fmt.Fprintf(
+			w,
+			"warning: skipping open entry @%d, timedot has no open records\n",
+			entry.ID,
+		)
+		if err != nil {
+			panic(fmt.Errorf("fprintf: %w", err))
+		}
+	}
+}
+
+// printTimedot prints a block per day with the decimal hours per account.
+func printTimedot(
+	w io.Writer,
+	dots twext.CompositeAggregation[dotKey, time.Duration],
+) {
+	var date string
+
+	for key, duration := range dots.Sorted(compareDotKeys) {
+		if key.date != date {
+			if date != "" {
+				writeLine(w)
+			}
+
+			writeLine(w, key.date)
+
+			date = key.date
+		}
+
+		writeLine(w, key.account+"  "+output.FormatHours(duration))
+	}
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+var (
+	errInvalidTemplate = errors.New("invalid account template")
+	errEmptyAccount    = errors.New("account name must not be empty")
+)
+
+//nolint:gochecknoglobals
+var placeholderPattern = regexp.MustCompile(`\{([0-9]+)\}`)
+
+// singleLine collapses all whitespace into single spaces. Two consecutive
+// spaces separate fields in the plain text accounting formats, so they must
+// not occur in account names and descriptions.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func parseAccountName(value twext.ConfigValue) (string, error) {
+	name := singleLine(value.String())
+	if name == "" {
+		return "", errEmptyAccount
+	}
+
+	return name, nil
+}
+
+// accountTemplate maps the tags of an entry to an account name. Each
+// placeholder {N} is replaced by the N-th tag of the entry. Placeholders
+// without a matching tag are replaced by the missing account name.
+type accountTemplate struct {
+	template string
+	missing  string
+}
+
+func (t accountTemplate) String() string {
+	return t.template
+}
+
+func parseAccountTemplate(value twext.ConfigValue) (accountTemplate, error) {
+	template, err := parseAccountName(value)
+	if err != nil {
+		return accountTemplate{}, fmt.Errorf("%w: %w", errInvalidTemplate, err)
+	}
+
+	matches := placeholderPattern.FindAllStringSubmatch(template, -1)
+	for _, match := range matches {
+		idx, err := strconv.Atoi(match[1])
+		if err != nil || idx < 1 {
+			return accountTemplate{}, fmt.Errorf(
+				"%w: placeholder %s must be a positive number",
+				errInvalidTemplate,
+				match[0],
+			)
+		}
+	}
+
+	return accountTemplate{template: template}, nil
+}
+
+// account returns the account name for the given tags.
+func (t accountTemplate) account(tags []string) string {
+	return placeholderPattern.ReplaceAllStringFunc(
+		t.template,
+		func(placeholder string) string {
+			// Placeholders are validated already in parseAccountTemplate.
+			idx, _ := strconv.Atoi(strings.Trim(placeholder, "{}"))
+			if idx > len(tags) {
+				return t.missing
+			}
+
+			if tag := singleLine(tags[idx-1]); tag != "" {
+				return tag
+			}
+
+			return t.missing
+		},
+	)
+}
+
+// record is a single time tracking record. End is zero for open entries.
+type record struct {
+	start       time.Time
+	end         time.Time
+	account     string
+	description string
+}
+
+func compareRecords(a, b record) int {
+	return cmp.Or(
+		a.start.Compare(b.start),
+		a.end.Compare(b.end),
+		cmp.Compare(a.account, b.account),
+		cmp.Compare(a.description, b.description),
+	)
+}
+
+// buildRecords returns a record per entry clipped to the report range,
+// sorted by time, so the output is deterministic.
+func buildRecords(entries twext.Entries, cfg config) []record {
+	records := make([]record, 0, len(entries))
+
+	for entry := range twext.InLocation(
+		twext.ClipEntries(entries.All(), cfg.reportRange),
+		cfg.location,
+	) {
+		records = append(records, record{
+			start:       entry.Start.Time,
+			end:         entry.End.Time,
+			account:     cfg.account.account(entry.Tags),
+			description: singleLine(entry.Annotation),
+		})
+	}
+
+	slices.SortFunc(records, compareRecords)
+
+	return records
+}
+
+// dotKey identifies a line of the timedot output.
+type dotKey struct {
+	date    string
+	account string
+}
+
+func compareDotKeys(a, b dotKey) int {
+	return cmp.Or(
+		cmp.Compare(a.date, b.date),
+		cmp.Compare(a.account, b.account),
+	)
+}
+
+// closedEntries filters out open entries. Their duration changes until they
+// are stopped, which would make the output non-deterministic.
+func closedEntries(entry twext.Entry) bool {
+	return !entry.IsActive()
+}
+
+func sumDuration(result time.Duration, entry twext.Entry) time.Duration {
+	return result + entry.Duration()
+}
+
+// buildDots sums up the tracked time per day and account. Entries are
+// clipped to the report range and split at midnight, so each part is
+// accounted for its own day.
+func buildDots(
+	entries twext.Entries,
+	cfg config,
+) twext.CompositeAggregation[dotKey, time.Duration] {
+	dotKeyOf := func(entry twext.Entry) dotKey {
+		return dotKey{
+			date:    entry.Start.Format(time.DateOnly),
+			account: cfg.account.account(entry.Tags),
+		}
+	}
+
+	return twext.AggregateComposite(
+		twext.SplitAtMidnight(twext.InLocation(
+			twext.ClipEntries(
+				twext.EntryFilter(closedEntries).Filter(entries.All()),
+				cfg.reportRange,
+			),
+			cfg.location,
+		)),
+		dotKeyOf,
+		sumDuration,
+	)
+}
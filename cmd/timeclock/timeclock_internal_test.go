@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAccountTemplate(t *testing.T) {
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    string
+		expectedErr error
+	}{
+		{input: "{1}", expected: "{1}"},
+		{input: " client:{1}  {2} ", expected: "client:{1} {2}"},
+		{input: "time", expected: "time"},
+		{input: "", expectedErr: errInvalidTemplate},
+		{input: "{0}", expectedErr: errInvalidTemplate},
+		{input: "{99999999999999999999}", expectedErr: errInvalidTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parseAccountTemplate(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual.String())
+		})
+	}
+}
+
+func TestAccountTemplate_Account(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		tags     []string
+		expected string
+	}{
+		{
+			name:     "all tags",
+			template: "client:{1}:{2}",
+			tags:     []string{"acme", "dev"},
+			expected: "client:acme:dev",
+		},
+		{
+			name:     "missing tags",
+			template: "client:{1}:{2}",
+			tags:     []string{"acme"},
+			expected: "client:acme:unknown",
+		},
+		{
+			name:     "repeated and reordered",
+			template: "{2}:{1}:{2}",
+			tags:     []string{"a", "b"},
+			expected: "b:a:b",
+		},
+		{
+			name:     "whitespace in tags",
+			template: "{1}:{2}",
+			tags:     []string{"two  words", " "},
+			expected: "two words:unknown",
+		},
+		{
+			name:     "literal braces",
+			template: "{x}:{1}",
+			tags:     []string{"a"},
+			expected: "{x}:a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := accountTemplate{
+				template: tt.template,
+				missing:  "unknown",
+			}
+			assert.Equal(t, tt.expected, template.account(tt.tags))
+		})
+	}
+}
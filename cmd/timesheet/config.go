@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "timesheet"
+	configKeyFormat   = "format"
+	defaultFormat     = "text"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+type config struct {
+	format      output.Format
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	format, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFormat),
+		defaultFormat,
+		output.FormatParser(output.FormatText, output.FormatMarkdown),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get format: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		format:      format,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Format:", cfg.format)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for printing weekly timesheet grids.
+package main
+
+import (
+	"fmt"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	sheets := buildSheets(entries, cfg)
+
+	switch cfg.format {
+	case output.FormatText:
+		printText(env.Out, sheets)
+	case output.FormatMarkdown:
+		printMarkdown(env.Out, sheets)
+	case output.FormatCSV, output.FormatJSON:
+		return fmt.Errorf("%w: %s", output.ErrUnknownFormat, cfg.format)
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "timesheet",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
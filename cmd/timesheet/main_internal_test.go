@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 10, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 9, 0), at(1, 12, 30), "projA").
+		EntryAt(at(1, 13, 0), at(1, 14, 15), "projB", "meeting").
+		EntryAt(at(3, 9, 0), at(3, 17, 0), "projA").
+		EntryAt(at(7, 23, 0), at(8, 1, 0), "projB").
+		EntryAt(at(9, 10, 0), at(9, 10, 45))
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *twexttest.Input
+		expectedErr error
+	}{
+		{
+			name:        "unsupported format",
+			input:       newTestInput().Config("timesheet.format", "json"),
+			expectedErr: output.ErrUnknownFormat,
+		},
+		{
+			name:  "text",
+			input: newTestInput(),
+		},
+		{
+			name:  "markdown",
+			input: newTestInput().Config("timesheet.format", "markdown"),
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config(twext.ConfigKeyReportStart, "20240624T000000Z").
+				Config(twext.ConfigKeyReportEnd, "20240708T000000Z"),
+		},
+		{
+			name: "timezone",
+			input: newTestInput().
+				Config("timesheet.timezone", "America/New_York"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			golden := "run_" + strings.ReplaceAll(tt.name, " ", "_")
+			twexttest.AssertGolden(t, golden, result.Stdout)
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+const totalLabel = "total"
+
+// formatCell formats a cell duration. Empty cells are left blank, so the
+// tracked days stand out.
+func formatCell(d time.Duration, exists bool) string {
+	if !exists {
+		return ""
+	}
+
+	return output.FormatHHMM(d)
+}
+
+func (s sheet) title() string {
+	sunday := s.monday.AddDate(0, 0, daysPerWeek-1)
+
+	return fmt.Sprintf(
+		"Week %s (%s - %s)",
+		s.week,
+		s.monday.Format(time.DateOnly),
+		sunday.Format(time.DateOnly),
+	)
+}
+
+func (s sheet) header() []string {
+	header := []string{"tag"}
+
+	for weekday := range daysPerWeek {
+		day := s.monday.AddDate(0, 0, weekday)
+		header = append(header, day.Format("Mon 02"))
+	}
+
+	return append(header, totalLabel)
+}
+
+// rows returns the formatted rows of the grid including the row with the
+// weekday totals.
+func (s sheet) rows() [][]string {
+	rows := make([][]string, 0, len(s.tags)+1)
+
+	for _, tag := range s.tags {
+		row := []string{tag}
+
+		for weekday := range daysPerWeek {
+			row = append(row, formatCell(s.cells.Cell(tag, weekday)))
+		}
+
+		rows = append(rows, append(row, output.FormatHHMM(s.tagTotals[tag])))
+	}
+
+	totals := []string{totalLabel}
+
+	for weekday := range daysPerWeek {
+		totals = append(totals, output.FormatHHMM(s.weekdayTotal[weekday]))
+	}
+
+	return append(rows, append(totals, output.FormatHHMM(s.total)))
+}
+
+func printText(w io.Writer, sheets []sheet) {
+	table := output.NewTable(w)
+
+	for _, s := range sheets {
+		table.Line("")
+		table.Line(s.title())
+		table.Row(s.header()...)
+
+		for _, row := range s.rows() {
+			table.Row(row...)
+		}
+	}
+
+	table.Flush()
+}
+
+func printMarkdown(w io.Writer, sheets []sheet) {
+	for idx, s := range sheets {
+		prefix := "\n"
+		if idx == 0 {
+			prefix = ""
+		}
+
+		_, err := fmt.Fprintf(w, "%s## %s\n\n", prefix, s.title())
+		if err != nil {
+			panic(fmt.Errorf("fprintf: %w", err))
+		}
+
+		table := output.NewMarkdownTable(w, s.header()...)
+
+		rows := s.rows()
+		for rowIdx, row := range rows {
+			if rowIdx == len(rows)-1 {
+				for col := range row {
+					row[col] = "**" + row[col] + "**"
+				}
+			}
+
+			table.Row(row...)
+		}
+	}
+}
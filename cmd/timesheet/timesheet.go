@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	daysPerWeek   = 7
+	untaggedLabel = "(untagged)"
+)
+
+// isoWeek is a week as defined by ISO 8601. Weeks start on Monday.
+type isoWeek struct {
+	year int
+	week int
+}
+
+func weekOf(t time.Time) isoWeek {
+	year, week := t.ISOWeek()
+
+	return isoWeek{year, week}
+}
+
+func (w isoWeek) String() string {
+	return fmt.Sprintf("%04d-W%02d", w.year, w.week)
+}
+
+func (w isoWeek) compare(o isoWeek) int {
+	return cmp.Or(cmp.Compare(w.year, o.year), cmp.Compare(w.week, o.week))
+}
+
+// monday returns the start of the week in the given location.
+func (w isoWeek) monday(location *time.Location) time.Time {
+	// The 4th of January is always in the first week.
+	jan4 := time.Date(w.year, time.January, 4, 0, 0, 0, 0, location)
+
+	return jan4.AddDate(0, 0, (w.week-1)*daysPerWeek-weekdayIndex(jan4))
+}
+
+// weekdayIndex returns the index of the weekday with Monday as 0 and Sunday
+// as 6.
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + daysPerWeek - 1) % daysPerWeek
+}
+
+type dayKey struct {
+	week    isoWeek
+	weekday int
+}
+
+func dayKeyOf(entry twext.Entry) dayKey {
+	return dayKey{
+		week:    weekOf(entry.Start.Time),
+		weekday: weekdayIndex(entry.Start.Time),
+	}
+}
+
+type cellKey struct {
+	week    isoWeek
+	weekday int
+	tag     string
+}
+
+func cellKeys(entry twext.Entry) []cellKey {
+	tags := entry.Tags
+	if len(tags) == 0 {
+		tags = []string{untaggedLabel}
+	}
+
+	keys := make([]cellKey, 0, len(tags))
+
+	for _, tag := range slices.Compact(slices.Sorted(slices.Values(tags))) {
+		keys = append(keys, cellKey{
+			week:    weekOf(entry.Start.Time),
+			weekday: weekdayIndex(entry.Start.Time),
+			tag:     tag,
+		})
+	}
+
+	return keys
+}
+
+// weekCells holds the cells of a single week.
+type weekCells = twext.CompositeAggregation[cellKey, time.Duration]
+
+func tagAndWeekday(key cellKey) (string, int) {
+	return key.tag, key.weekday
+}
+
+func sumDuration(result time.Duration, entry twext.Entry) time.Duration {
+	return result + entry.Duration()
+}
+
+func add(a, b time.Duration) time.Duration {
+	return a + b
+}
+
+// sheet is the timesheet grid of a single week with a row per tag and a
+// column per weekday. Entries with multiple tags count for each of their tag
+// rows, but only once for the weekday totals and the total.
+type sheet struct {
+	week         isoWeek
+	monday       time.Time
+	tags         []string
+	cells        twext.PivotTable[string, int, time.Duration]
+	tagTotals    map[string]time.Duration
+	weekdayTotal map[int]time.Duration
+	total        time.Duration
+}
+
+func newSheet(
+	week isoWeek,
+	cells weekCells,
+	weekdayTotals map[int]time.Duration,
+	location *time.Location,
+) sheet {
+	table := twext.Pivot(cells, tagAndWeekday)
+	s := sheet{
+		week:         week,
+		monday:       week.monday(location),
+		tags:         table.Rows(cmp.Compare[string]),
+		cells:        table,
+		tagTotals:    table.RowTotals(add),
+		weekdayTotal: weekdayTotals,
+	}
+
+	for _, total := range s.weekdayTotal {
+		s.total += total
+	}
+
+	return s
+}
+
+// reportWeeks returns all weeks touched by the report range. The range must
+// be closed, otherwise no weeks are returned.
+func reportWeeks(
+	reportRange twext.Interval,
+	location *time.Location,
+) []isoWeek {
+	if reportRange.Start.IsZero() || reportRange.End.IsZero() {
+		return nil
+	}
+
+	var weeks []isoWeek
+
+	monday := weekOf(reportRange.Start.In(location)).monday(location)
+	for monday.Before(reportRange.End) {
+		weeks = append(weeks, weekOf(monday))
+		monday = monday.AddDate(0, 0, daysPerWeek)
+	}
+
+	return weeks
+}
+
+// buildSheets creates a sheet for every week of the report range and every
+// week with entries. Entries are clipped to the report range and split at
+// midnight, so each part is accounted for its own day. The day totals are
+// aggregated separately from the cells, so entries with multiple tags count
+// only once for them.
+func buildSheets(entries twext.Entries, cfg config) []sheet {
+	pieces := twext.Entries(slices.Collect(twext.SplitAtMidnight(
+		twext.InLocation(
+			twext.ClipEntries(entries.All(), cfg.reportRange),
+			cfg.location,
+		),
+	)))
+
+	cells := twext.AggregateFanOut(pieces.All(), cellKeys, sumDuration)
+	dayTotals := twext.AggregateComposite(pieces.All(), dayKeyOf, sumDuration)
+
+	weeks := make(map[isoWeek]weekCells)
+	weekdayTotals := make(map[isoWeek]map[int]time.Duration)
+
+	for _, week := range reportWeeks(cfg.reportRange, cfg.location) {
+		weeks[week] = weekCells{}
+	}
+
+	for key, duration := range cells {
+		if weeks[key.week] == nil {
+			weeks[key.week] = weekCells{}
+		}
+
+		weeks[key.week][key] = duration
+	}
+
+	for key, duration := range dayTotals {
+		if weekdayTotals[key.week] == nil {
+			weekdayTotals[key.week] = make(map[int]time.Duration)
+		}
+
+		weekdayTotals[key.week][key.weekday] = duration
+	}
+
+	sheets := make([]sheet, 0, len(weeks))
+
+	for _, week := range slices.SortedFunc(maps.Keys(weeks), isoWeek.compare) {
+		sheets = append(sheets, newSheet(
+			week,
+			weeks[week],
+			weekdayTotals[week],
+			cfg.location,
+		))
+	}
+
+	return sheets
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsoWeek_Monday(t *testing.T) {
+	tests := []struct {
+		week     isoWeek
+		expected string
+	}{
+		{week: isoWeek{2024, 27}, expected: "2024-07-01"},
+		{week: isoWeek{2020, 53}, expected: "2020-12-28"},
+		{week: isoWeek{2021, 1}, expected: "2021-01-04"},
+		{week: isoWeek{2025, 1}, expected: "2024-12-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.week.String(), func(t *testing.T) {
+			monday := tt.week.monday(time.UTC)
+			assert.Equal(t, tt.expected, monday.Format(time.DateOnly))
+			assert.Equal(t, tt.week, weekOf(monday))
+		})
+	}
+}
+
+func TestWeekdayIndex(t *testing.T) {
+	monday := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	for idx := range daysPerWeek {
+		assert.Equal(t, idx, weekdayIndex(monday.AddDate(0, 0, idx)))
+	}
+}
+
+func TestBuildSheets_MultipleTags(t *testing.T) {
+	clock := func(day, hour int) twext.Time {
+		return twext.Time{
+			Time: time.Date(2024, 7, day, hour, 0, 0, 0, time.UTC),
+		}
+	}
+
+	entries := twext.Entries{
+		{
+			Start: clock(1, 9),
+			End:   clock(1, 11),
+			Tags:  []string{"projA", "meeting"},
+		},
+		{
+			Start: clock(2, 9),
+			End:   clock(2, 10),
+			Tags:  []string{"projA"},
+		},
+	}
+
+	sheets := buildSheets(entries, config{location: time.UTC})
+	require.Len(t, sheets, 1)
+
+	s := sheets[0]
+	assert.Equal(t, []string{"meeting", "projA"}, s.tags)
+	assert.Equal(t, 2*time.Hour, s.tagTotals["meeting"])
+	assert.Equal(t, 3*time.Hour, s.tagTotals["projA"])
+	assert.Equal(t, 2*time.Hour, s.weekdayTotal[0])
+	assert.Equal(t, time.Hour, s.weekdayTotal[1])
+	assert.Equal(t, 3*time.Hour, s.total)
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+var errUnknownSeverity = errors.New("unknown severity")
+
+type severity int
+
+const (
+	severityInfo severity = iota
+	severityWarning
+	severityError
+)
+
+func (s severity) String() string {
+	switch s {
+	case severityInfo:
+		return "info"
+	case severityWarning:
+		return "warning"
+	case severityError:
+		return "error"
+	}
+
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+func parseSeverity(value twext.ConfigValue) (severity, error) {
+	for _, s := range []severity{severityInfo, severityWarning, severityError} {
+		if strings.EqualFold(value.String(), s.String()) {
+			return s, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", errUnknownSeverity, value)
+}
+
+// finding is a problem found by a check. It refers to the IDs of all entries
+// involved.
+type finding struct {
+	check    string
+	severity severity
+	ids      []int
+	message  string
+}
+
+// checkFunc returns the findings for the given entries. The check name and
+// severity are set by the caller.
+type checkFunc func(entries twext.Entries, cfg config) []finding
+
+type check struct {
+	name     string
+	severity severity
+	run      checkFunc
+}
+
+// Names of the available checks.
+const (
+	checkOverlap  = "overlap"
+	checkNegative = "negative"
+	checkLong     = "long"
+	checkOpen     = "open"
+	checkUntagged = "untagged"
+	checkFragment = "fragment"
+)
+
+// availableChecks returns all checks with their default severity.
+func availableChecks() []check {
+	return []check{
+		{checkOverlap, severityError, findOverlaps},
+		{checkNegative, severityError, entryCheck(endBeforeStart)},
+		{checkLong, severityWarning, entryCheck(tooLong)},
+		{checkOpen, severityWarning, entryCheck(openFromPreviousDay)},
+		{checkUntagged, severityWarning, entryCheck(untagged)},
+		{checkFragment, severityInfo, entryCheck(tooShort)},
+	}
+}
+
+// entryCheck creates a [checkFunc] from a function that checks a single
+// entry. The function returns the message and true for a finding.
+func entryCheck(fn func(twext.Entry, config) (string, bool)) checkFunc {
+	return func(entries twext.Entries, cfg config) []finding {
+		var findings []finding
+
+		for _, entry := range entries {
+			message, found := fn(entry, cfg)
+			if found {
+				findings = append(findings, finding{
+					ids:     []int{entry.ID},
+					message: message,
+				})
+			}
+		}
+
+		return findings
+	}
+}
+
+func isNegative(entry twext.Entry) bool {
+	return !entry.IsActive() && entry.End.Before(entry.Start.Time)
+}
+
+func endBeforeStart(entry twext.Entry, _ config) (string, bool) {
+	if !isNegative(entry) {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"ends %s before it starts",
+		output.FormatDuration(entry.Start.Sub(entry.End.Time)),
+	), true
+}
+
+func tooLong(entry twext.Entry, cfg config) (string, bool) {
+	if entry.Duration() <= cfg.maxDuration {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"lasts %s, more than %s",
+		output.FormatDuration(entry.Duration()),
+		output.FormatDuration(cfg.maxDuration),
+	), true
+}
+
+func openFromPreviousDay(entry twext.Entry, cfg config) (string, bool) {
+	if !entry.IsActive() {
+		return "", false
+	}
+
+	start := twext.Time{Time: entry.Start.In(cfg.location)}
+	now := twext.Time{Time: twext.Now().In(cfg.location)}
+
+	if start.SameDate(&now) {
+		return "", false
+	}
+
+	return "still open since " + start.Format(time.DateOnly), true
+}
+
+func untagged(entry twext.Entry, _ config) (string, bool) {
+	return "has no tags", len(entry.Tags) == 0
+}
+
+func tooShort(entry twext.Entry, cfg config) (string, bool) {
+	if entry.IsActive() || isNegative(entry) ||
+		entry.Duration() >= cfg.minDuration {
+		return "", false
+	}
+
+	return fmt.Sprintf(
+		"lasts only %s, less than %s",
+		output.FormatDuration(entry.Duration()),
+		output.FormatDuration(cfg.minDuration),
+	), true
+}
+
+// findOverlaps finds entries that start before a previous entry ended.
+// Entries ending before they start are ignored, as they are reported by
+// their own check.
+func findOverlaps(entries twext.Entries, _ config) []finding {
+	sorted := slices.SortedStableFunc(
+		twext.EntryFilter(func(e twext.Entry) bool {
+			return !isNegative(e)
+		}).Filter(entries.All()),
+		func(a, b twext.Entry) int {
+			return a.Start.Compare(b.Start.Time)
+		},
+	)
+
+	var (
+		findings []finding
+		latest   twext.Entry
+	)
+
+	for idx, entry := range sorted {
+		if idx > 0 && entry.Start.Before(latest.CurrentEnd().Time) {
+			end := entry.CurrentEnd().Time
+			if latestEnd := latest.CurrentEnd().Time; latestEnd.Before(end) {
+				end = latestEnd
+			}
+
+			findings = append(findings, finding{
+				ids: []int{latest.ID, entry.ID},
+				message: fmt.Sprintf(
+					"overlap by %s",
+					output.FormatDuration(end.Sub(entry.Start.Time)),
+				),
+			})
+		}
+
+		if idx == 0 || entry.CurrentEnd().After(latest.CurrentEnd().Time) {
+			latest = entry
+		}
+	}
+
+	return findings
+}
+
+// lint runs all enabled checks. Findings are sorted by severity, most severe
+// first. Findings of the same severity keep the order of the checks.
+func lint(entries twext.Entries, cfg config) []finding {
+	var findings []finding
+
+	for _, c := range cfg.checks {
+		for _, f := range c.run(entries, cfg) {
+			f.check = c.name
+			f.severity = c.severity
+			findings = append(findings, f)
+		}
+	}
+
+	slices.SortStableFunc(findings, func(a, b finding) int {
+		return cmp.Compare(b.severity, a.severity)
+	})
+
+	return findings
+}
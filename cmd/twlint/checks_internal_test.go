@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntry(id int, start, end string) twext.Entry {
+	entry := twext.Entry{ID: id, Start: twext.MustParseTime(start)}
+	if end != "" {
+		entry.End = twext.MustParseTime(end)
+	}
+
+	return entry
+}
+
+func TestFindOverlaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  twext.Entries
+		expected []finding
+	}{
+		{
+			name: "adjacent",
+			entries: twext.Entries{
+				testEntry(2, "20240701T080000Z", "20240701T090000Z"),
+				testEntry(1, "20240701T090000Z", "20240701T100000Z"),
+			},
+		},
+		{
+			name: "nested",
+			entries: twext.Entries{
+				testEntry(3, "20240701T080000Z", "20240701T120000Z"),
+				testEntry(2, "20240701T090000Z", "20240701T093000Z"),
+				testEntry(1, "20240701T110000Z", "20240701T130000Z"),
+			},
+			expected: []finding{
+				{ids: []int{3, 2}, message: "overlap by 0h:30m"},
+				{ids: []int{3, 1}, message: "overlap by 1h:00m"},
+			},
+		},
+		{
+			name: "unsorted",
+			entries: twext.Entries{
+				testEntry(2, "20240701T090000Z", "20240701T100000Z"),
+				testEntry(1, "20240701T080000Z", "20240701T091500Z"),
+			},
+			expected: []finding{
+				{ids: []int{1, 2}, message: "overlap by 0h:15m"},
+			},
+		},
+		{
+			name: "open entry",
+			entries: twext.Entries{
+				testEntry(2, "20240701T080000Z", ""),
+				testEntry(1, "20240701T090000Z", "20240701T100000Z"),
+			},
+			expected: []finding{
+				{ids: []int{2, 1}, message: "overlap by 1h:00m"},
+			},
+		},
+		{
+			name: "negative entry ignored",
+			entries: twext.Entries{
+				testEntry(2, "20240701T100000Z", "20240701T080000Z"),
+				testEntry(1, "20240701T090000Z", "20240701T093000Z"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(
+				t,
+				time.Date(2024, 7, 1, 11, 0, 0, 0, time.UTC),
+			)
+
+			actual := findOverlaps(tt.entries, config{})
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	for _, s := range []severity{severityInfo, severityWarning, severityError} {
+		actual, err := parseSeverity(twext.ConfigValue(s.String()))
+		require.NoError(t, err)
+		assert.Equal(t, s, actual)
+	}
+
+	_, err := parseSeverity("critical")
+	require.ErrorIs(t, err, errUnknownSeverity)
+}
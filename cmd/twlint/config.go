@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "twlint"
+	configKeyEnabled  = "enabled"
+	defaultEnabled    = "on"
+	configKeySeverity = "severity"
+	configKeyMax      = "max"
+	defaultMax        = "12h"
+	configKeyMin      = "min"
+	defaultMin        = "1m"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+var errNotPositive = errors.New("duration must be positive")
+
+type config struct {
+	checks      []check
+	maxDuration time.Duration
+	minDuration time.Duration
+	location    *time.Location
+}
+
+func configKey(name ...string) twext.ConfigKey {
+	return twext.NewConfigKey(append([]string{configKeyPrefix}, name...)...)
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	checks, err := readChecks(rawCfg)
+	if err != nil {
+		return config{}, fmt.Errorf("get checks: %w", err)
+	}
+
+	maxDuration, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(checkLong, configKeyMax),
+		defaultMax,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get maximum duration: %w", err)
+	}
+
+	minDuration, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(checkFragment, configKeyMin),
+		defaultMin,
+		parsePositiveDuration,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get minimum duration: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		checks:      checks,
+		maxDuration: maxDuration,
+		minDuration: minDuration,
+		location:    location,
+	}
+
+	for _, c := range cfg.checks {
+		log.Printf("cfg - Check: %s (%s)", c.name, c.severity)
+	}
+
+	log.Println("cfg - MaxDuration:", cfg.maxDuration)
+	log.Println("cfg - MinDuration:", cfg.minDuration)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
+
+// readChecks returns the enabled checks with their configured severity.
+func readChecks(rawCfg twext.Config) ([]check, error) {
+	var checks []check
+
+	for _, c := range availableChecks() {
+		enabled, err := twext.ReadConfigValue(
+			rawCfg,
+			configKey(c.name, configKeyEnabled),
+			defaultEnabled,
+			parseBool,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("check %s: %w", c.name, err)
+		}
+
+		if !enabled {
+			continue
+		}
+
+		c.severity, err = twext.ReadConfigValue(
+			rawCfg,
+			configKey(c.name, configKeySeverity),
+			twext.ConfigValue(c.severity.String()),
+			parseSeverity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("check %s: %w", c.name, err)
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
+
+func parseBool(value twext.ConfigValue) (bool, error) {
+	return value.Bool(), nil
+}
+
+func parsePositiveDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("%w: %s", errNotPositive, duration)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for finding data quality problems in the
+// recorded intervals.
+package main
+
+import (
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// errFindings signals findings with severity error by the exit status. The
+// findings themselves are already printed as report.
+const errFindings twext.ExitStatusError = 1
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	findings := lint(entries, cfg)
+
+	printFindings(env.Out, findings)
+
+	if countBySeverity(findings)[severityError] > 0 {
+		return errFindings
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "twlint",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		Entry(-50*time.Hour, -34*time.Hour, "long").
+		Entry(-30*time.Hour, -29*time.Hour).
+		Entry(-29*time.Hour-30*time.Minute, -28*time.Hour, "overlap").
+		Entry(-27*time.Hour, -27*time.Hour+30*time.Second, "fragment").
+		EntryAt(
+			testNow.Add(-26*time.Hour),
+			testNow.Add(-27*time.Hour),
+			"negative",
+		).
+		OpenEntry(-25*time.Hour, "open")
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedErr    error
+	}{
+		{
+			name: "invalid severity",
+			input: newTestInput().
+				Config("twlint.overlap.severity", "fatal"),
+			expectedErr: errUnknownSeverity,
+		},
+		{
+			name: "invalid maximum",
+			input: newTestInput().
+				Config("twlint.long.max", "0s"),
+			expectedErr: errNotPositive,
+		},
+		{
+			name: "no findings",
+			input: twexttest.NewInput(testNow).
+				Config(twext.ConfigKeyVerbose, "on").
+				Entry(-2*time.Hour, -time.Hour, "tag"),
+			expectedStdout: `
+No findings.
+`,
+		},
+		{
+			name:        "all checks",
+			input:       newTestInput(),
+			expectedErr: errFindings,
+			expectedStdout: `
+    severity       check    entries                                message
+       error     overlap      @5 @4                      overlap by 0h:30m
+       error    negative         @2           ends 1h:00m before it starts
+     warning        long         @6       lasts 16h:00m, more than 12h:00m
+     warning        long         @1       lasts 25h:00m, more than 12h:00m
+     warning        open         @1            still open since 2024-06-30
+     warning    untagged         @5                            has no tags
+        info    fragment         @3    lasts only 0h:00m, less than 0h:01m
+
+2 errors, 4 warnings, 1 infos
+`,
+		},
+		{
+			name: "configured checks",
+			input: newTestInput().
+				Config("twlint.overlap.severity", "warning").
+				Config("twlint.negative.enabled", "off").
+				Config("twlint.long.max", "24h").
+				Config("twlint.untagged.enabled", "no").
+				Config("twlint.fragment.severity", "ERROR").
+				Config("twlint.fragment.min", "2m"),
+			expectedErr: errFindings,
+			expectedStdout: `
+    severity       check    entries                                message
+       error    fragment         @3    lasts only 0h:00m, less than 0h:02m
+     warning     overlap      @5 @4                      overlap by 0h:30m
+     warning        long         @1       lasts 25h:00m, more than 24h:00m
+     warning        open         @1            still open since 2024-06-30
+
+1 errors, 3 warnings, 0 infos
+`,
+		},
+		{
+			name: "timezone",
+			input: twexttest.NewInput(testNow).
+				Config("twlint.timezone", "Europe/Berlin").
+				Config("twlint.long.enabled", "off").
+				OpenEntry(-13*time.Hour, "open"),
+			expectedStdout: `
+No findings.
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Empty(t, result.Stderr, "stderr")
+		})
+	}
+}
+
+func TestRun_Debug(t *testing.T) {
+	input := twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyDebug, "on").
+		Config("twlint.open.enabled", "off")
+
+	result := twexttest.Run(newReport().Run, input.String())
+	require.NoError(t, result.Err)
+
+	assert.Contains(t, result.Stderr, "cfg - Check: overlap (error)\n")
+	assert.NotContains(t, result.Stderr, "cfg - Check: open")
+}
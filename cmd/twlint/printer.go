@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+func formatIDs(ids []int) string {
+	formatted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		formatted = append(formatted, "@"+strconv.Itoa(id))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func countBySeverity(findings []finding) map[severity]int {
+	counts := make(map[severity]int)
+	for _, f := range findings {
+		counts[f.severity]++
+	}
+
+	return counts
+}
+
+func printFindings(w io.Writer, findings []finding) {
+	table := output.NewTable(w)
+	table.Line("")
+
+	if len(findings) == 0 {
+		table.Line("No findings.")
+		table.Flush()
+
+		return
+	}
+
+	table.Row("severity", "check", "entries", "message")
+
+	for _, f := range findings {
+		table.Row(f.severity.String(), f.check, formatIDs(f.ids), f.message)
+	}
+
+	counts := countBySeverity(findings)
+
+	table.Line("")
+	table.Line(fmt.Sprintf(
+		"%d errors, %d warnings, %d infos",
+		counts[severityError],
+		counts[severityWarning],
+		counts[severityInfo],
+	))
+	table.Flush()
+}
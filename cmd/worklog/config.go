@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const (
+	configKeyPrefix   = "worklog"
+	configKeyPattern  = "pattern"
+	defaultPattern    = `[A-Z][A-Z0-9]+-[0-9]+`
+	configKeyFormat   = "format"
+	defaultFormat     = "json"
+	configKeyTimezone = "timezone"
+	defaultTimezone   = "local"
+)
+
+var errEmptyPattern = errors.New("pattern must not be empty")
+
+type config struct {
+	pattern     *regexp.Regexp
+	format      output.Format
+	reportRange twext.Interval
+	location    *time.Location
+}
+
+func configKey(name string) twext.ConfigKey {
+	return twext.NewConfigKey(configKeyPrefix, name)
+}
+
+func parsePattern(value twext.ConfigValue) (*regexp.Regexp, error) {
+	if value == "" {
+		return nil, errEmptyPattern
+	}
+
+	pattern, err := regexp.Compile(value.String())
+	if err != nil {
+		return nil, fmt.Errorf("compile: %w", err)
+	}
+
+	return pattern, nil
+}
+
+func parseConfig(rawCfg twext.Config) (config, error) {
+	pattern, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyPattern),
+		defaultPattern,
+		parsePattern,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get pattern: %w", err)
+	}
+
+	format, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyFormat),
+		defaultFormat,
+		output.FormatParser(output.FormatJSON, output.FormatCSV),
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get format: %w", err)
+	}
+
+	reportRange, err := rawCfg.ReportRange()
+	if err != nil {
+		return config{}, fmt.Errorf("get report range: %w", err)
+	}
+
+	location, err := twext.ReadConfigValue(
+		rawCfg,
+		configKey(configKeyTimezone),
+		defaultTimezone,
+		twext.ConfigValue.Location,
+	)
+	if err != nil {
+		return config{}, fmt.Errorf("get timezone: %w", err)
+	}
+
+	cfg := config{
+		pattern:     pattern,
+		format:      format,
+		reportRange: reportRange,
+		location:    location,
+	}
+
+	log.Println("cfg - Pattern:", cfg.pattern)
+	log.Println("cfg - Format:", cfg.format)
+	log.Println("cfg - ReportRange:", cfg.reportRange)
+	log.Println("cfg - Timezone:", cfg.location)
+
+	return cfg, nil
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Implements a timewarrior extension for exporting worklogs per issue and day
+// for the import into Jira or Tempo.
+package main
+
+import (
+	"fmt"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+func render(env twext.Env, cfg config, entries twext.Entries) error {
+	worklogs, skip := buildWorklogs(entries, cfg)
+
+	printWarnings(env.Err, skip)
+
+	switch cfg.format {
+	case output.FormatJSON:
+		return printJSON(env.Out, worklogs)
+	case output.FormatCSV:
+		return printCSV(env.Out, worklogs)
+	case output.FormatText, output.FormatMarkdown:
+		return fmt.Errorf("%w: %s", output.ErrUnknownFormat, cfg.format)
+	}
+
+	return nil
+}
+
+func newReport() twext.Report[config] {
+	return twext.Report[config]{
+		Name:        "worklog",
+		ParseConfig: parseConfig,
+		Render:      render,
+	}
+}
+
+func main() {
+	newReport().Main()
+}
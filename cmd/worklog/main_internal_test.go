@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	twexttest.Main(m)
+}
+
+var testNow = time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, 7, day, hour, minute, 0, 0, time.UTC)
+}
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		EntryAt(at(1, 13, 0), at(1, 14, 0), "ABC-12", "dev").
+		Annotate("fix login").
+		EntryAt(at(1, 9, 0), at(1, 11, 30), "dev", "ABC-12").
+		EntryAt(at(1, 11, 30), at(1, 12, 0), "meeting").
+		EntryAt(at(1, 15, 0), at(1, 15, 45), "review").
+		Annotate("review of XY-7, part 2").
+		EntryAt(at(1, 23, 0), at(2, 1, 0), "ABC-12").
+		Annotate("fix login")
+}
+
+const expectedWarning = "warning: no issue key in entry " +
+	"2024-07-01 11:30 - 12:00 (0h:30m), tags: meeting\n"
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          *twexttest.Input
+		expectedStdout string
+		expectedStderr string
+		expectedErr    error
+	}{
+		{
+			name:        "unsupported format",
+			input:       newTestInput().Config("worklog.format", "text"),
+			expectedErr: output.ErrUnknownFormat,
+		},
+		{
+			name:        "empty pattern",
+			input:       newTestInput().Config("worklog.pattern", ""),
+			expectedErr: errEmptyPattern,
+		},
+		{
+			name:           "no entries",
+			input:          twexttest.NewInput(testNow).Config("debug", "off"),
+			expectedStdout: "[]\n",
+		},
+		{
+			name:  "json",
+			input: newTestInput(),
+			expectedStdout: `[
+  {
+    "issueKey": "ABC-12",
+    "started": "2024-07-01T09:00:00.000+0000",
+    "timeSpentSeconds": 16200,
+    "comment": "fix login"
+  },
+  {
+    "issueKey": "XY-7",
+    "started": "2024-07-01T15:00:00.000+0000",
+    "timeSpentSeconds": 2700,
+    "comment": "review of XY-7, part 2"
+  },
+  {
+    "issueKey": "ABC-12",
+    "started": "2024-07-02T00:00:00.000+0000",
+    "timeSpentSeconds": 3600,
+    "comment": "fix login"
+  }
+]
+`,
+			expectedStderr: expectedWarning,
+		},
+		{
+			name: "csv and timezone",
+			input: newTestInput().
+				Config("worklog.format", "csv").
+				Config("worklog.timezone", "Europe/Berlin"),
+			expectedStdout: `issueKey,started,timeSpentSeconds,comment
+ABC-12,2024-07-01T11:00:00.000+0200,12600,fix login
+XY-7,2024-07-01T17:00:00.000+0200,2700,"review of XY-7, part 2"
+ABC-12,2024-07-02T01:00:00.000+0200,7200,fix login
+`,
+			expectedStderr: "warning: no issue key in entry " +
+				"2024-07-01 13:30 - 14:00 (0h:30m), tags: meeting\n",
+		},
+		{
+			name: "report range",
+			input: newTestInput().
+				Config("worklog.format", "csv").
+				Config(twext.ConfigKeyReportStart, "20240701T100000Z").
+				Config(twext.ConfigKeyReportEnd, "20240702T000000Z"),
+			expectedStdout: `issueKey,started,timeSpentSeconds,comment
+ABC-12,2024-07-01T10:00:00.000+0000,12600,fix login
+XY-7,2024-07-01T15:00:00.000+0000,2700,"review of XY-7, part 2"
+`,
+			expectedStderr: expectedWarning,
+		},
+		{
+			name: "active entry",
+			input: newTestInput().
+				Config("worklog.format", "csv").
+				EntryAt(at(3, 11, 0), time.Time{}, "ABC-13"),
+			expectedStdout: `issueKey,started,timeSpentSeconds,comment
+ABC-12,2024-07-01T09:00:00.000+0000,16200,fix login
+XY-7,2024-07-01T15:00:00.000+0000,2700,"review of XY-7, part 2"
+ABC-12,2024-07-02T00:00:00.000+0000,3600,fix login
+`,
+			expectedStderr: "warning: skipping active entry started " +
+				"2024-07-03 11:00, stop it to export its worklog\n" +
+				expectedWarning,
+		},
+		{
+			name: "custom pattern",
+			input: newTestInput().
+				Config("worklog.pattern", `ABC-\d+`).
+				Config("worklog.format", "csv"),
+			expectedStdout: `issueKey,started,timeSpentSeconds,comment
+ABC-12,2024-07-01T09:00:00.000+0000,16200,fix login
+ABC-12,2024-07-02T00:00:00.000+0000,3600,fix login
+`,
+			expectedStderr: expectedWarning +
+				"warning: no issue key in entry 2024-07-01 15:00 - 15:45 " +
+				"(0h:45m), tags: review, annotation: review of XY-7, part 2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twexttest.FixedClock(t, testNow)
+
+			result := twexttest.Run(newReport().Run, tt.input.String())
+			require.ErrorIs(t, result.Err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, result.Stdout, "stdout")
+			assert.Equal(t, tt.expectedStderr, result.Stderr, "stderr")
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+)
+
+// startedFormat is the format of the started timestamp as expected by the
+// Jira worklog API.
+const startedFormat = "2006-01-02T15:04:05.000-0700"
+
+type jsonWorklog struct {
+	IssueKey         string `json:"issueKey"`
+	Started          string `json:"started"`
+	TimeSpentSeconds int64  `json:"timeSpentSeconds"`
+	Comment          string `json:"comment"`
+}
+
+func seconds(d time.Duration) int64 {
+	return int64(d.Round(time.Second).Seconds())
+}
+
+func printJSON(w io.Writer, worklogs []worklog) error {
+	doc := make([]jsonWorklog, 0, len(worklogs))
+
+	for _, wl := range worklogs {
+		doc = append(doc, jsonWorklog{
+			IssueKey:         wl.issue,
+			Started:          wl.started.Format(startedFormat),
+			TimeSpentSeconds: seconds(wl.spent),
+			Comment:          wl.comment(),
+		})
+	}
+
+	return output.WriteJSON(w, doc)
+}
+
+func printCSV(w io.Writer, worklogs []worklog) error {
+	records := [][]string{
+		{"issueKey", "started", "timeSpentSeconds", "comment"},
+	}
+
+	for _, wl := range worklogs {
+		records = append(records, []string{
+			wl.issue,
+			wl.started.Format(startedFormat),
+			strconv.FormatInt(seconds(wl.spent), 10),
+			wl.comment(),
+		})
+	}
+
+	err := csv.NewWriter(w).WriteAll(records)
+	if err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+
+	return nil
+}
+
+// printWarnings prints a warning for each skipped entry, so no tracked time
+// goes missing unnoticed.
+func printWarnings(w io.Writer, skip skipped) {
+	for _, entry := range skip.active {
+		_, err := fmt.Fprintf(
+			w,
+			"warning: skipping active entry started %s, "+
+				"stop it to export its worklog\n",
+			entry.Start.Format("2006-01-02 15:04"),
+		)
+		if err != nil {
+			panic(fmt.Errorf("fprintf: %w", err))
+		}
+	}
+
+	for _, entry := range skip.unmatched {
+		description := "no tags"
+		if len(entry.Tags) > 0 {
+			description = "tags: " + strings.Join(entry.Tags, ", ")
+		}
+
+		if entry.Annotation != "" {
+			description += ", annotation: " + entry.Annotation
+		}
+
+		_, err := fmt.Fprintf(
+			w,
+			"warning: no issue key in entry %s - %s (%s), %s\n",
+			entry.Start.Format("2006-01-02 15:04"),
+			entry.End.Format("15:04"),
+			output.FormatDuration(entry.Duration()),
+			description,
+		)
+		if err != nil {
+			panic(fmt.Errorf("fprintf: %w", err))
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// worklogKey identifies the worklog of an issue on a single day.
+type worklogKey struct {
+	date  string
+	issue string
+}
+
+func compareWorklogKeys(a, b worklogKey) int {
+	return cmp.Or(
+		cmp.Compare(a.date, b.date),
+		cmp.Compare(a.issue, b.issue),
+	)
+}
+
+// worklog is the time spent on an issue on a single day.
+type worklog struct {
+	issue    string
+	started  time.Time
+	spent    time.Duration
+	comments []string
+}
+
+// comment returns the annotations of the entries. If there are none, a
+// generic comment is returned, as the comment must not be empty.
+func (w worklog) comment() string {
+	if len(w.comments) == 0 {
+		return "Working on issue " + w.issue
+	}
+
+	return strings.Join(w.comments, "; ")
+}
+
+// issueKey returns the first issue key found in the tags of the entry. If
+// none of the tags contains an issue key, the annotation is searched.
+func (c config) issueKey(entry twext.Entry) (string, bool) {
+	for _, tag := range entry.Tags {
+		if key := c.pattern.FindString(tag); key != "" {
+			return key, true
+		}
+	}
+
+	key := c.pattern.FindString(entry.Annotation)
+
+	return key, key != ""
+}
+
+// skipped are the entries that are not exported as worklogs.
+type skipped struct {
+	// unmatched are the entries without issue key.
+	unmatched twext.Entries
+	// active are the entries that are still running. Their duration changes
+	// until they are stopped, so they would create wrong worklogs.
+	active twext.Entries
+}
+
+// buildWorklogs sums up the time spent per issue and day. Entries are clipped
+// to the report range and split at midnight, so each part is accounted for
+// its own day. Active entries and entries without issue key are returned
+// separately.
+func buildWorklogs(
+	entries twext.Entries,
+	cfg config,
+) ([]worklog, skipped) {
+	var (
+		matched twext.Entries
+		skip    skipped
+	)
+
+	for entry := range twext.InLocation(
+		twext.ClipEntries(entries.All(), cfg.reportRange),
+		cfg.location,
+	) {
+		_, found := cfg.issueKey(entry)
+
+		switch {
+		case entry.IsActive():
+			skip.active = append(skip.active, entry)
+		case found:
+			matched = append(matched, entry)
+		default:
+			skip.unmatched = append(skip.unmatched, entry)
+		}
+	}
+
+	keyOf := func(entry twext.Entry) worklogKey {
+		issue, _ := cfg.issueKey(entry)
+
+		return worklogKey{
+			date:  entry.Start.Format(time.DateOnly),
+			issue: issue,
+		}
+	}
+
+	addEntry := func(w worklog, entry twext.Entry) worklog {
+		if w.started.IsZero() || entry.Start.Before(w.started) {
+			w.started = entry.Start.Time
+		}
+
+		w.issue, _ = cfg.issueKey(entry)
+		w.spent += entry.Duration()
+
+		annotation := strings.TrimSpace(entry.Annotation)
+		if annotation != "" && !slices.Contains(w.comments, annotation) {
+			w.comments = append(w.comments, annotation)
+		}
+
+		return w
+	}
+
+	aggregated := twext.AggregateComposite(
+		twext.SplitAtMidnight(matched.All()),
+		keyOf,
+		addEntry,
+	)
+
+	worklogs := make([]worklog, 0, len(aggregated))
+
+	for _, w := range aggregated.Sorted(compareWorklogKeys) {
+		worklogs = append(worklogs, w)
+	}
+
+	return worklogs, skip
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueKey(t *testing.T) {
+	cfg := config{pattern: regexp.MustCompile(defaultPattern)}
+
+	tests := []struct {
+		name     string
+		entry    twext.Entry
+		expected string
+		found    bool
+	}{
+		{
+			name:  "no tags and annotation",
+			entry: twext.Entry{},
+		},
+		{
+			name:     "tag",
+			entry:    twext.Entry{Tags: []string{"dev", "ABC-1"}},
+			expected: "ABC-1",
+			found:    true,
+		},
+		{
+			name:     "within tag",
+			entry:    twext.Entry{Tags: []string{"jira:ABC-1"}},
+			expected: "ABC-1",
+			found:    true,
+		},
+		{
+			name: "first tag wins",
+			entry: twext.Entry{
+				Tags:       []string{"ABC-2", "ABC-1"},
+				Annotation: "XY-3",
+			},
+			expected: "ABC-2",
+			found:    true,
+		},
+		{
+			name: "annotation",
+			entry: twext.Entry{
+				Tags:       []string{"dev"},
+				Annotation: "working on XY-3 and XY-4",
+			},
+			expected: "XY-3",
+			found:    true,
+		},
+		{
+			name:  "lower case",
+			entry: twext.Entry{Tags: []string{"abc-1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, found := cfg.issueKey(tt.entry)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
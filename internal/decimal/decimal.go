@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package decimal implements exact decimal arithmetic for money amounts.
+//
+// Values are stored as rational numbers, so intermediate results like the
+// price for 20 minutes at an hourly rate are exact. They must be rounded with
+// [Decimal.Round] to the desired number of decimal places, for example cents,
+// before they are summed up and presented.
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"time"
+)
+
+// ErrInvalid is returned if a string is not a valid decimal number.
+var ErrInvalid = errors.New("invalid decimal")
+
+//nolint:gochecknoglobals
+var decimalPattern = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// Decimal is an exact decimal number. The zero value is 0.
+type Decimal struct {
+	rat *big.Rat
+}
+
+// Parse parses a decimal number in plain notation like "95" or "-12.50".
+func Parse(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+
+	rat, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalid, s)
+	}
+
+	return Decimal{rat}, nil
+}
+
+// MustParse is like [Parse] but panics on error. It simplifies
+// initialization of constants and test values.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// New returns the decimal value*10^-scale. New(1995, 2) is 19.95. A negative
+// scale is treated as 0.
+func New(value int64, scale int) Decimal {
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+
+	return Decimal{new(big.Rat).SetFrac(big.NewInt(value), denom)}
+}
+
+// Hours returns the duration as exact number of hours.
+func Hours(d time.Duration) Decimal {
+	return Decimal{big.NewRat(int64(d), int64(time.Hour))}
+}
+
+func (d Decimal) value() *big.Rat {
+	if d.rat == nil {
+		return new(big.Rat)
+	}
+
+	return d.rat
+}
+
+// Add returns d+o.
+func (d Decimal) Add(o Decimal) Decimal {
+	return Decimal{new(big.Rat).Add(d.value(), o.value())}
+}
+
+// Sub returns d-o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	return Decimal{new(big.Rat).Sub(d.value(), o.value())}
+}
+
+// Mul returns d*o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{new(big.Rat).Mul(d.value(), o.value())}
+}
+
+// Div returns d/o. It panics if o is 0.
+func (d Decimal) Div(o Decimal) Decimal {
+	return Decimal{new(big.Rat).Quo(d.value(), o.value())}
+}
+
+// Round returns d rounded to the given number of decimal places. Halves are
+// rounded away from zero.
+func (d Decimal) Round(places int) Decimal {
+	rat, _ := new(big.Rat).SetString(d.value().FloatString(places))
+
+	return Decimal{rat}
+}
+
+// Cmp compares d and o and returns -1, 0 or +1 like [big.Rat.Cmp].
+func (d Decimal) Cmp(o Decimal) int {
+	return d.value().Cmp(o.value())
+}
+
+// IsZero checks if d is 0.
+func (d Decimal) IsZero() bool {
+	return d.value().Sign() == 0
+}
+
+// Format formats d with the given number of decimal places. The last digit
+// is rounded like with [Decimal.Round].
+func (d Decimal) Format(places int) string {
+	return d.value().FloatString(places)
+}
+
+// String formats d with as many decimal places as needed, if d is a finite
+// decimal. Otherwise, d is formatted as fraction like "1/3".
+func (d Decimal) String() string {
+	value := d.value()
+
+	places, exact := value.FloatPrec()
+	if !exact {
+		return value.RatString()
+	}
+
+	return value.FloatString(places)
+}
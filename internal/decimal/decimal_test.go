@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package decimal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    string
+		expectedErr error
+	}{
+		{input: "95", expected: "95"},
+		{input: "95.00", expected: "95"},
+		{input: "-12.5", expected: "-12.5"},
+		{input: "+0.10", expected: "0.1"},
+		{input: "", expectedErr: decimal.ErrInvalid},
+		{input: "1/3", expectedErr: decimal.ErrInvalid},
+		{input: "1e3", expectedErr: decimal.ErrInvalid},
+		{input: "1,5", expectedErr: decimal.ErrInvalid},
+		{input: ".5", expectedErr: decimal.ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			actual, err := decimal.Parse(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expected, actual.String())
+		})
+	}
+}
+
+func TestDecimal_ZeroValue(t *testing.T) {
+	var zero decimal.Decimal
+
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "0", zero.String())
+	assert.Equal(t, "0.00", zero.Format(2))
+	assert.Equal(t, "1.5", zero.Add(decimal.MustParse("1.5")).String())
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := decimal.MustParse("0.1")
+	b := decimal.MustParse("0.2")
+
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, "0.5", a.Div(b).String())
+	assert.Equal(t, "1/3", a.Div(decimal.MustParse("0.3")).String())
+	assert.Panics(t, func() { a.Div(decimal.Decimal{}) })
+	assert.Equal(t, 0, a.Add(b).Cmp(decimal.MustParse("0.3")))
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, "19.95", decimal.New(1995, 2).String())
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		input    decimal.Decimal
+		places   int
+		expected string
+	}{
+		{input: decimal.MustParse("1.005"), places: 2, expected: "1.01"},
+		{input: decimal.MustParse("1.004"), places: 2, expected: "1"},
+		{input: decimal.MustParse("-1.005"), places: 2, expected: "-1.01"},
+		{input: decimal.MustParse("2.5"), places: 0, expected: "3"},
+		{
+			input:    decimal.Hours(20 * time.Minute),
+			places:   4,
+			expected: "0.3333",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.input.Round(tt.places).String())
+		})
+	}
+}
+
+func TestHours(t *testing.T) {
+	rate := decimal.MustParse("95.00")
+
+	amount := rate.Mul(decimal.Hours(20 * time.Minute))
+
+	assert.Equal(t, "95/3", amount.String())
+	assert.Equal(t, "31.67", amount.Format(2))
+	assert.Equal(t, "1.25", decimal.Hours(75*time.Minute).String())
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package groupkey provides the keys the extensions of this repository group
+// entries by.
+package groupkey
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// ErrAmbiguousTag is returned if an entry has multiple different tags that
+// match.
+var ErrAmbiguousTag = errors.New("entry matches multiple tags")
+
+// ISOWeek returns the ISO 8601 week of the given time, like "2024-W27".
+func ISOWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// UniqueTag returns the tag of the entry that is a key of the given map, like
+// the tag an hourly rate is configured for. It returns an empty string, if the
+// entry has no such tag, and [ErrAmbiguousTag], if it has multiple different
+// ones.
+func UniqueTag[V any](entry twext.Entry, known map[string]V) (string, error) {
+	var match string
+
+	for _, tag := range entry.Tags {
+		if _, exists := known[tag]; !exists || tag == match {
+			continue
+		}
+
+		if match != "" {
+			return "", fmt.Errorf(
+				"%w: entry %d: %s, %s",
+				ErrAmbiguousTag,
+				entry.ID,
+				match,
+				tag,
+			)
+		}
+
+		match = tag
+	}
+
+	return match, nil
+}
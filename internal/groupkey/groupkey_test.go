@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package groupkey_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/groupkey"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestISOWeek(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		expected string
+	}{
+		{
+			input:    time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			expected: "2024-W27",
+		},
+		{
+			input:    time.Date(2024, 7, 7, 23, 59, 0, 0, time.UTC),
+			expected: "2024-W27",
+		},
+		{
+			input:    time.Date(2024, 12, 30, 12, 0, 0, 0, time.UTC),
+			expected: "2025-W01",
+		},
+		{
+			input:    time.Date(2021, 1, 3, 12, 0, 0, 0, time.UTC),
+			expected: "2020-W53",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, groupkey.ISOWeek(tt.input))
+		})
+	}
+}
+
+func TestUniqueTag(t *testing.T) {
+	known := map[string]int{
+		"acme":    1,
+		"initech": 2,
+	}
+
+	tests := []struct {
+		name        string
+		tags        []string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "no tags",
+		},
+		{
+			name: "no known tag",
+			tags: []string{"meeting"},
+		},
+		{
+			name:     "known tag",
+			tags:     []string{"meeting", "acme"},
+			expected: "acme",
+		},
+		{
+			name:     "duplicate known tag",
+			tags:     []string{"acme", "acme"},
+			expected: "acme",
+		},
+		{
+			name:        "multiple known tags",
+			tags:        []string{"acme", "initech"},
+			expectedErr: groupkey.ErrAmbiguousTag,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := twext.Entry{Tags: tt.tags}
+
+			actual, err := groupkey.UniqueTag(entry, known)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
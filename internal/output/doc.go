@@ -0,0 +1,10 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package output provides common output helpers for the extensions of this
+// repository.
+//
+// Write errors of the text based writers cause panics, which are recovered
+// and returned as error by [twext.Report.Run].
+package output
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const minutesPerHour = 60
+
+// FormatDuration formats the duration as hours and minutes, like "7h:05m".
+// Negative durations are prefixed with "-".
+func FormatDuration(d time.Duration) string {
+	var prefix string
+
+	if d < 0 {
+		prefix = "-"
+	}
+
+	return fmt.Sprintf(
+		"%s%dh:%02dm",
+		prefix,
+		int64(d.Abs().Hours()),
+		int64(d.Abs().Minutes())%minutesPerHour,
+	)
+}
+
+// FormatHHMM formats the duration as hours and minutes in clock notation,
+// like "07:05". Hours are not limited to 24. Negative durations are prefixed
+// with "-".
+func FormatHHMM(d time.Duration) string {
+	var prefix string
+
+	if d < 0 {
+		prefix = "-"
+	}
+
+	return fmt.Sprintf(
+		"%s%02d:%02d",
+		prefix,
+		int64(d.Abs().Hours()),
+		int64(d.Abs().Minutes())%minutesPerHour,
+	)
+}
+
+// FormatHours formats the duration as decimal hours with two decimal places,
+// like "7.08". It is meant for machine readable output like CSV.
+func FormatHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{input: 0, expected: "0h:00m"},
+		{input: 59 * time.Second, expected: "0h:00m"},
+		{input: 7*time.Hour + 5*time.Minute, expected: "7h:05m"},
+		{input: 31*time.Hour + 59*time.Minute, expected: "31h:59m"},
+		{input: -90 * time.Minute, expected: "-1h:30m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, output.FormatDuration(tt.input))
+		})
+	}
+}
+
+func TestFormatHHMM(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{input: 0, expected: "00:00"},
+		{input: 7*time.Hour + 5*time.Minute, expected: "07:05"},
+		{input: 31*time.Hour + 59*time.Minute, expected: "31:59"},
+		{input: -90 * time.Minute, expected: "-01:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, output.FormatHHMM(tt.input))
+		})
+	}
+}
+
+func TestFormatHours(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{input: 0, expected: "0.00"},
+		{input: 7*time.Hour + 5*time.Minute, expected: "7.08"},
+		{input: 90 * time.Minute, expected: "1.50"},
+		{input: -15 * time.Minute, expected: "-0.25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, output.FormatHours(tt.input))
+		})
+	}
+}
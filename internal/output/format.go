@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+// ErrUnknownFormat is returned if an output format is not supported.
+var ErrUnknownFormat = errors.New("unknown output format")
+
+// Format is an output format.
+type Format string
+
+// Supported output formats.
+const (
+	FormatText     Format = "text"
+	FormatCSV      Format = "csv"
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+)
+
+// FormatParser returns a function that parses a [twext.ConfigValue] as one of
+// the given formats. It can be used with [twext.ReadConfigValue].
+func FormatParser(
+	supported ...Format,
+) func(twext.ConfigValue) (Format, error) {
+	return func(value twext.ConfigValue) (Format, error) {
+		format := Format(value)
+		if !slices.Contains(supported, format) {
+			return "", fmt.Errorf("%w: %s", ErrUnknownFormat, value)
+		}
+
+		return format, nil
+	}
+}
+
+// WriteJSON writes the given value as indented JSON document.
+func WriteJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(v)
+	if err != nil {
+		return fmt.Errorf("encode json: %w", err)
+	}
+
+	return nil
+}
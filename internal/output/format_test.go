@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatParser(t *testing.T) {
+	parse := output.FormatParser(output.FormatText, output.FormatJSON)
+
+	tests := []struct {
+		input       twext.ConfigValue
+		expected    output.Format
+		expectedErr error
+	}{
+		{input: "text", expected: output.FormatText},
+		{input: "json", expected: output.FormatJSON},
+		{input: "csv", expectedErr: output.ErrUnknownFormat},
+		{input: "", expectedErr: output.ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := parse(tt.input)
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	out := &strings.Builder{}
+
+	err := output.WriteJSON(out, map[string]int{"a": 1})
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": 1\n}\n", out.String())
+
+	err = output.WriteJSON(out, func() {})
+	require.Error(t, err)
+}
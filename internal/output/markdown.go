@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownTable writes rows as Markdown table. The first column is left
+// aligned, all other columns are right aligned.
+type MarkdownTable struct {
+	writer io.Writer
+}
+
+// NewMarkdownTable creates a new [MarkdownTable] and writes the header row
+// with the given columns.
+func NewMarkdownTable(w io.Writer, header ...string) *MarkdownTable {
+	table := &MarkdownTable{writer: w}
+	table.Row(header...)
+
+	separator := make([]string, len(header))
+	for idx := range separator {
+		separator[idx] = "---:"
+	}
+
+	if len(separator) > 0 {
+		separator[0] = "---"
+	}
+
+	table.write(separator)
+
+	return table
+}
+
+// Row writes a single row with the given columns. Pipe characters in the
+// columns are escaped.
+func (t *MarkdownTable) Row(columns ...string) {
+	escaped := make([]string, 0, len(columns))
+	for _, column := range columns {
+		escaped = append(escaped, MarkdownEscape(column))
+	}
+
+	t.write(escaped)
+}
+
+func (t *MarkdownTable) write(columns []string) {
+	_, err := fmt.Fprintf(t.writer, "| %s |\n", strings.Join(columns, " | "))
+	if err != nil {
+		panic(fmt.Errorf("fprintf: %w", err))
+	}
+}
+
+// MarkdownEscape escapes characters that break Markdown tables.
+func MarkdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownTable(t *testing.T) {
+	var out strings.Builder
+
+	table := output.NewMarkdownTable(&out, "name", "value")
+	table.Row("a|b", "1")
+	table.Row("**total**", "")
+
+	expected := `| name | value |
+| --- | ---: |
+| a\|b | 1 |
+| **total** |  |
+`
+	assert.Equal(t, expected, out.String())
+}
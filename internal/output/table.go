@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const tabPadding = 4
+
+// Table writes rows of columns aligned as text table. Like the flextime
+// output, cells are right aligned.
+type Table struct {
+	writer *tabwriter.Writer
+}
+
+// NewTable creates a new [Table] writing to the given writer. Call
+// [Table.Flush] after the last row has been written.
+func NewTable(w io.Writer) *Table {
+	return &Table{
+		writer: tabwriter.NewWriter(
+			w, 0, 0, tabPadding, ' ', tabwriter.AlignRight,
+		),
+	}
+}
+
+// Row writes a single row with the given columns.
+func (t *Table) Row(columns ...string) {
+	t.printf("%s\t\n", strings.Join(columns, "\t"))
+}
+
+// Line writes a line that is not part of the table columns, like an empty
+// line or a heading. It must not contain tabs.
+func (t *Table) Line(line string) {
+	t.printf("%s\n", line)
+}
+
+// Flush writes the buffered rows.
+func (t *Table) Flush() {
+	err := t.writer.Flush()
+	if err != nil {
+		panic(fmt.Errorf("flush: %w", err))
+	}
+}
+
+func (t *Table) printf(format string, args ...any) {
+	_, err := fmt.Fprintf(t.writer, format, args...)
+	if err != nil {
+		panic(fmt.Errorf("fprintf: %w", err))
+	}
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package output_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/internal/output"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, assert.AnError
+}
+
+func TestTable(t *testing.T) {
+	out := &strings.Builder{}
+
+	table := output.NewTable(out)
+	table.Line("")
+	table.Row("tag", "total")
+	table.Row("projA", "10h:00m")
+	table.Row("b", "0h:30m")
+	table.Flush()
+
+	expected := `
+      tag      total
+    projA    10h:00m
+        b     0h:30m
+`
+	assert.Equal(t, expected, out.String())
+}
+
+func TestTable_WriteError(t *testing.T) {
+	table := output.NewTable(failingWriter{})
+	table.Row("a", "b")
+
+	assert.PanicsWithError(t, "flush: "+assert.AnError.Error(), table.Flush)
+}
@@ -2,7 +2,7 @@
 //
 // SPDX-License-Identifier: GPL-3.0-or-later
 
-package main
+package timetarget
 
 import (
 	"errors"
@@ -15,18 +15,21 @@ import (
 
 type entriesTransformation func(entries twext.EntryIterator) twext.EntryIterator
 
-type aggregationStrategy[K twext.AggregationKey, V any] struct {
+// AggregationStrategy aggregates entries per key, like the tracked time per
+// day. Entries may be transformed before, like split at midnight.
+type AggregationStrategy[K twext.AggregationKey, V any] struct {
 	name      string
 	keyFn     twext.AggregationKeyFunc[K]
 	valueFn   twext.AggregationValueFunc[V]
 	transform entriesTransformation
 }
 
-func (s *aggregationStrategy[K, V]) String() string {
+func (s *AggregationStrategy[K, V]) String() string {
 	return s.name
 }
 
-func (s *aggregationStrategy[K, V]) Aggregate(
+// Aggregate aggregates the entries with the strategy.
+func (s *AggregationStrategy[K, V]) Aggregate(
 	entries twext.EntryIterator,
 ) twext.Aggregation[K, V] {
 	if s.transform != nil {
@@ -57,51 +60,42 @@ func onlySingleDays(entry twext.Entry) bool {
 	return sameDate
 }
 
-func splitIntoDaysAtMidnight(entries twext.EntryIterator) twext.EntryIterator {
-	return func(yield func(twext.Entry) bool) {
-		for entry := range entries {
-			for e := range twext.SplitIntoDays(entry, time.Time{}) {
-				if !yield(e) {
-					return
-				}
-			}
-		}
-	}
-}
-
-var errUnknownAggregationStrategy = errors.New("unknown aggregation strategy")
+// ErrUnknownAggregationStrategy is returned for unknown strategy names.
+var ErrUnknownAggregationStrategy = errors.New("unknown aggregation strategy")
 
-func createAggregationStrategy(
+// NewAggregationStrategy returns the strategy with the given name for
+// aggregating the tracked time per day.
+func NewAggregationStrategy(
 	strategy string,
-) (*aggregationStrategy[string, time.Duration], error) {
+) (*AggregationStrategy[string, time.Duration], error) {
 	switch strategy {
 	case "single-day-only":
-		return &aggregationStrategy[string, time.Duration]{
+		return &AggregationStrategy[string, time.Duration]{
 			name:      strategy,
 			keyFn:     startDate,
 			valueFn:   sumDuration,
 			transform: twext.EntryFilter(onlySingleDays).Filter,
 		}, nil
 	case "into-start-date":
-		return &aggregationStrategy[string, time.Duration]{
+		return &AggregationStrategy[string, time.Duration]{
 			name:    strategy,
 			keyFn:   startDate,
 			valueFn: sumDuration,
 		}, nil
 	case "into-end-date":
-		return &aggregationStrategy[string, time.Duration]{
+		return &AggregationStrategy[string, time.Duration]{
 			name:    strategy,
 			keyFn:   endDate,
 			valueFn: sumDuration,
 		}, nil
 	case "split-at-midnight":
-		return &aggregationStrategy[string, time.Duration]{
+		return &AggregationStrategy[string, time.Duration]{
 			name:      strategy,
 			keyFn:     startDate,
 			valueFn:   sumDuration,
-			transform: splitIntoDaysAtMidnight,
+			transform: twext.SplitAtMidnight,
 		}, nil
 	}
 
-	return nil, fmt.Errorf("%w: %s", errUnknownAggregationStrategy, strategy)
+	return nil, fmt.Errorf("%w: %s", ErrUnknownAggregationStrategy, strategy)
 }
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package timetarget implements the daily time targets and the aggregation
+// strategies for the tracked time per day as configured for the flextime
+// extension. Other extensions can use them as reference for the tracked time
+// per day.
+package timetarget
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+const numberOfWeekdays = 7
+
+const (
+	// ConfigKeyPrefix is the prefix of the time target config keys.
+	ConfigKeyPrefix          = "flextime"
+	configKeyTimeTarget      = "time_per_day"
+	configSubKeyDateOverride = "date"
+	defaultTimeTarget        = "8h"
+)
+
+// Targets holds the daily time targets. Date specific targets have
+// precedence over weekday specific targets, which have precedence over the
+// default target.
+type Targets struct {
+	dates           map[time.Time]time.Duration
+	weekdays        map[time.Weekday]time.Duration
+	defaultDuration time.Duration
+}
+
+func (t Targets) String() string {
+	str := &strings.Builder{}
+	_, _ = fmt.Fprintf(str, "Default: %s", t.defaultDuration)
+
+	for day, duration := range t.weekdays {
+		_, _ = fmt.Fprintf(str, " %s: %s", day, duration)
+	}
+
+	return str.String()
+}
+
+// For returns the time target for the date of the given day.
+func (t Targets) For(day time.Time) time.Duration {
+	cleanDay := time.Date(
+		day.Year(), day.Month(), day.Day(),
+		0, 0, 0, 0,
+		time.UTC,
+	)
+
+	if override, exists := t.dates[cleanDay]; exists {
+		return override
+	}
+
+	if target, exists := t.weekdays[cleanDay.Weekday()]; exists {
+		return target
+	}
+
+	return t.defaultDuration
+}
+
+// Read reads the time targets from the config keys below
+// [ConfigKeyPrefix]:
+//
+//   - time_per_day: the default target, 8h if not set.
+//   - time_per_day.<weekday>: weekday specific targets.
+//   - time_per_day.date.<date>: date specific targets.
+func Read(twConfig twext.Config) (Targets, error) {
+	var err error
+
+	targets := Targets{
+		dates:    make(map[time.Time]time.Duration),
+		weekdays: make(map[time.Weekday]time.Duration, numberOfWeekdays),
+	}
+
+	targets.defaultDuration, err = twext.ReadConfigValue(
+		twConfig,
+		twext.NewConfigKey(ConfigKeyPrefix, configKeyTimeTarget),
+		defaultTimeTarget,
+		parseDuration,
+	)
+	if err != nil {
+		return Targets{}, fmt.Errorf("get default target: %w", err)
+	}
+
+	for day := range time.Weekday(numberOfWeekdays) {
+		subKey := strings.ToLower(day.String())
+		key := twext.NewConfigKey(ConfigKeyPrefix, configKeyTimeTarget, subKey)
+		cfgValue, exists := twConfig[key]
+
+		if !exists {
+			continue
+		}
+
+		targets.weekdays[day], err = parseDuration(cfgValue)
+		if err != nil {
+			return Targets{}, fmt.Errorf("get target for %s: %w", day, err)
+		}
+	}
+
+	overrideKey := twext.NewConfigKey(
+		ConfigKeyPrefix,
+		configKeyTimeTarget,
+		configSubKeyDateOverride,
+	)
+	for key, override := range twConfig {
+		subKey, match := key.SubKey(overrideKey)
+		if !match {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, subKey.String())
+		if err != nil {
+			return Targets{}, fmt.Errorf("get date for override: %w", err)
+		}
+
+		targets.dates[date], err = parseDuration(override)
+		if err != nil {
+			return Targets{}, fmt.Errorf("get target for %s: %w", date, err)
+		}
+	}
+
+	return targets, nil
+}
+
+func parseDuration(value twext.ConfigValue) (time.Duration, error) {
+	duration, err := value.Duration()
+	if err != nil {
+		return 0, fmt.Errorf("convert to duration: %w", err)
+	}
+
+	return duration, nil
+}
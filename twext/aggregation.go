@@ -9,6 +9,7 @@ import (
 	"iter"
 	"maps"
 	"slices"
+	"sync"
 )
 
 // AggregationKey defines the interface for types that can be used as
@@ -28,17 +29,107 @@ func (g Aggregation[K, V]) SortedKeys() []K {
 // Sorted returns an iterator that iterates the [Aggregation] sorted by
 // [AggregationKey]s.
 func (g Aggregation[K, V]) Sorted() iter.Seq2[K, V] {
+	return sortedSeq(g, g.SortedKeys())
+}
+
+// KeyCompareFunc compares two keys. It returns a negative number if a is less
+// than b, a positive number if a is greater than b and zero if they are
+// equal. See [cmp.Compare] for the natural order of ordered types.
+type KeyCompareFunc[K comparable] func(a, b K) int
+
+// CompositeAggregation is a map of any values aggregated by a common
+// comparable key.
+//
+// In contrast to [Aggregation], the keys do not need a natural order. This
+// allows struct keys composed of multiple values, like a date and a tag. The
+// order is defined by a [KeyCompareFunc] when iterating.
+type CompositeAggregation[K comparable, V any] map[K]V
+
+// SortedKeys returns the list of keys sorted by the given [KeyCompareFunc].
+func (g CompositeAggregation[K, V]) SortedKeys(compare KeyCompareFunc[K]) []K {
+	return slices.SortedFunc(maps.Keys(g), compare)
+}
+
+// Sorted returns an iterator that iterates the [CompositeAggregation] sorted
+// by keys using the given [KeyCompareFunc].
+func (g CompositeAggregation[K, V]) Sorted(
+	compare KeyCompareFunc[K],
+) iter.Seq2[K, V] {
+	return sortedSeq(g, g.SortedKeys(compare))
+}
+
+// Merge merges the other [Aggregation] into this one. Values of keys present
+// in both are combined with the given [AggregationMergeFunc]. Values of keys
+// only present in other are copied.
+func (g Aggregation[K, V]) Merge(
+	other Aggregation[K, V],
+	mergeFn AggregationMergeFunc[V],
+) {
+	mergeInto(g, other, mergeFn)
+}
+
+// Merge merges the other [CompositeAggregation] into this one. See
+// [Aggregation.Merge].
+func (g CompositeAggregation[K, V]) Merge(
+	other CompositeAggregation[K, V],
+	mergeFn AggregationMergeFunc[V],
+) {
+	mergeInto(g, other, mergeFn)
+}
+
+func mergeInto[K comparable, V any](
+	dst, src map[K]V,
+	mergeFn AggregationMergeFunc[V],
+) {
+	for key, value := range src {
+		if existing, exists := dst[key]; exists {
+			value = mergeFn(existing, value)
+		}
+
+		dst[key] = value
+	}
+}
+
+func sortedSeq[K comparable, V any](m map[K]V, keys []K) iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
-		for _, key := range g.SortedKeys() {
-			if !yield(key, g[key]) {
+		for _, key := range keys {
+			if !yield(key, m[key]) {
 				return
 			}
 		}
 	}
 }
 
+// KeyPair is a composite key of two ordered values. It can be used as key for
+// a [CompositeAggregation] and split into the rows and columns of a
+// [PivotTable] with [KeyPair.Split].
+type KeyPair[A, B AggregationKey] struct {
+	First  A
+	Second B
+}
+
+// Compare compares the [KeyPair] with another one. The first values take
+// precedence over the second ones. It can be used as [KeyCompareFunc] in the
+// form of the method expression KeyPair[A, B].Compare.
+func (p KeyPair[A, B]) Compare(o KeyPair[A, B]) int {
+	return cmp.Or(
+		cmp.Compare(p.First, o.First),
+		cmp.Compare(p.Second, o.Second),
+	)
+}
+
+// Split returns both values of the [KeyPair].
+func (p KeyPair[A, B]) Split() (A, B) {
+	return p.First, p.Second
+}
+
 // AggregationKeyFunc returns the aggregation key for the given entry.
-type AggregationKeyFunc[K AggregationKey] func(Entry) K
+type AggregationKeyFunc[K comparable] func(Entry) K
+
+// AggregationKeysFunc returns any number of aggregation keys for the given
+// entry. The entry is added to the result of each key. If no keys are
+// returned, the entry is skipped.
+type AggregationKeysFunc[K comparable] func(Entry) []K
 
 // AggregationValueFunc adds an [Entry] to an aggregation result.
 //
@@ -46,6 +137,13 @@ type AggregationKeyFunc[K AggregationKey] func(Entry) K
 // The value can be a single scalar value or a slice.
 type AggregationValueFunc[V any] func(result V, entry Entry) (newResult V)
 
+// AggregationMergeFunc combines two partial aggregation results of the same
+// key.
+//
+// The first result always stems from entries that precede the entries of the
+// second one. For slices, appending b to a keeps the order of the entries.
+type AggregationMergeFunc[V any] func(a, b V) V
+
 // Aggregate aggregates entries into a map with user defined keys and values.
 //
 // It iterates the given entries and returns an [Aggregation] map with keys
@@ -59,6 +157,46 @@ func Aggregate[K AggregationKey, V any](
 	keyFn AggregationKeyFunc[K],
 	valueFn AggregationValueFunc[V],
 ) Aggregation[K, V] {
+	return aggregate(entries, keyFn, valueFn)
+}
+
+// AggregateComposite is like [Aggregate] but allows any comparable key, like
+// structs composed of multiple values.
+func AggregateComposite[K comparable, V any](
+	entries EntryIterator,
+	keyFn AggregationKeyFunc[K],
+	valueFn AggregationValueFunc[V],
+) CompositeAggregation[K, V] {
+	return aggregate(entries, keyFn, valueFn)
+}
+
+// AggregateFanOut is like [AggregateComposite] but an entry can contribute
+// to multiple keys, e.g. one per tag. The [AggregationValueFunc] is called
+// once for each key returned by the [AggregationKeysFunc].
+//
+// For keys that have a natural order, [cmp.Compare] can be used as
+// [KeyCompareFunc] for sorting the result.
+func AggregateFanOut[K comparable, V any](
+	entries EntryIterator,
+	keysFn AggregationKeysFunc[K],
+	valueFn AggregationValueFunc[V],
+) CompositeAggregation[K, V] {
+	aggregated := map[K]V{}
+
+	for entry := range entries {
+		for _, key := range keysFn(entry) {
+			aggregated[key] = valueFn(aggregated[key], entry)
+		}
+	}
+
+	return aggregated
+}
+
+func aggregate[K comparable, V any](
+	entries EntryIterator,
+	keyFn AggregationKeyFunc[K],
+	valueFn AggregationValueFunc[V],
+) map[K]V {
 	aggregated := map[K]V{}
 
 	for entry := range entries {
@@ -68,3 +206,80 @@ func Aggregate[K AggregationKey, V any](
 
 	return aggregated
 }
+
+// DefaultChunkSize is the number of entries aggregated by a single worker in
+// one go by [AggregateParallel].
+const DefaultChunkSize = 4096
+
+// AggregateParallel is like [Aggregate] but splits the entries into chunks
+// that are aggregated concurrently by the given number of workers. The
+// partial aggregations are combined by the [AggregationMergeFunc] in the
+// order of the chunks, so the result is deterministic and equal to the
+// result of [Aggregate] as long as the [AggregationMergeFunc] is consistent
+// with the [AggregationValueFunc].
+//
+// The [AggregationKeyFunc] and [AggregationValueFunc] are called
+// concurrently and must be safe for concurrent use. The iterator is consumed
+// sequentially. If workers is less than 1, a single worker is used.
+func AggregateParallel[K AggregationKey, V any](
+	entries EntryIterator,
+	workers int,
+	keyFn AggregationKeyFunc[K],
+	valueFn AggregationValueFunc[V],
+	mergeFn AggregationMergeFunc[V],
+) Aggregation[K, V] {
+	type chunk struct {
+		idx     int
+		entries Entries
+	}
+
+	workers = max(workers, 1)
+	chunks := make(chan chunk, workers)
+	partials := map[int]map[K]V{}
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for range workers {
+		wg.Go(func() {
+			for c := range chunks {
+				partial := aggregate(c.entries.All(), keyFn, valueFn)
+
+				mutex.Lock()
+				partials[c.idx] = partial
+				mutex.Unlock()
+			}
+		})
+	}
+
+	numChunks := 0
+	current := make(Entries, 0, DefaultChunkSize)
+
+	for entry := range entries {
+		current = append(current, entry)
+		if len(current) < DefaultChunkSize {
+			continue
+		}
+
+		chunks <- chunk{numChunks, current}
+		numChunks++
+		current = make(Entries, 0, DefaultChunkSize)
+	}
+
+	if len(current) > 0 {
+		chunks <- chunk{numChunks, current}
+		numChunks++
+	}
+
+	close(chunks)
+	wg.Wait()
+
+	aggregated := Aggregation[K, V]{}
+	for idx := range numChunks {
+		mergeInto(aggregated, partials[idx], mergeFn)
+	}
+
+	return aggregated
+}
@@ -5,6 +5,7 @@
 package twext_test
 
 import (
+	"cmp"
 	"fmt"
 	"time"
 
@@ -93,3 +94,81 @@ func ExampleAggregate_reduce() {
 	// 2010-06-29 9h0m0s
 	// 2010-06-30 8h0m0s
 }
+
+func ExampleAggregateFanOut() {
+	entries := twext.Entries{
+		twext.Entry{
+			Start: parseTime("20100629T080000Z"),
+			End:   parseTime("20100629T140000Z"),
+			Tags:  []string{"projA", "meeting"},
+		},
+		twext.Entry{
+			Start: parseTime("20100629T150000Z"),
+			End:   parseTime("20100629T180000Z"),
+			Tags:  []string{"projB"},
+		},
+		twext.Entry{
+			Start: parseTime("20100630T080000Z"),
+			End:   parseTime("20100630T160000Z"),
+			Tags:  []string{"projA"},
+		},
+	}
+
+	type key = twext.KeyPair[string, string]
+
+	sum := twext.AggregateFanOut(
+		entries.All(),
+		func(entry twext.Entry) []key {
+			keys := make([]key, 0, len(entry.Tags))
+			for _, tag := range entry.Tags {
+				keys = append(keys, key{
+					First:  entry.Start.Format(time.DateOnly),
+					Second: tag,
+				})
+			}
+
+			return keys
+		},
+		func(result time.Duration, entry twext.Entry) time.Duration {
+			return result + entry.Duration()
+		},
+	)
+
+	for key, group := range sum.Sorted(key.Compare) {
+		fmt.Println(key.First, key.Second, group)
+	}
+	// Output:
+	// 2010-06-29 meeting 6h0m0s
+	// 2010-06-29 projA 6h0m0s
+	// 2010-06-29 projB 3h0m0s
+	// 2010-06-30 projA 8h0m0s
+}
+
+func ExamplePivot() {
+	type key = twext.KeyPair[string, string]
+
+	sum := twext.CompositeAggregation[key, time.Duration]{
+		{"projA", "2010-06-29"}: 6 * time.Hour,
+		{"projB", "2010-06-29"}: 3 * time.Hour,
+		{"projA", "2010-06-30"}: 8 * time.Hour,
+	}
+
+	table := twext.Pivot(sum, key.Split)
+	columns := table.Columns(cmp.Compare[string])
+
+	fmt.Println("tag", columns)
+
+	for _, row := range table.Rows(cmp.Compare[string]) {
+		values := make([]time.Duration, 0, len(columns))
+		for _, column := range columns {
+			value, _ := table.Cell(row, column)
+			values = append(values, value)
+		}
+
+		fmt.Println(row, values)
+	}
+	// Output:
+	// tag [2010-06-29 2010-06-30]
+	// projA [6h0m0s 8h0m0s]
+	// projB [3h0m0s 0s]
+}
@@ -6,8 +6,11 @@ package twext_test
 
 import (
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aibor/timewarrior-extensions/twext"
 	"github.com/stretchr/testify/assert"
@@ -223,3 +226,343 @@ func BenchmarkAggregate(b *testing.B) {
 		})
 	}
 }
+
+func TestCompositeAggregation_Sorted(t *testing.T) {
+	type key = twext.KeyPair[string, int]
+
+	aggregation := twext.CompositeAggregation[key, int]{
+		{"b", 1}: 1,
+		{"a", 2}: 2,
+		{"a", 1}: 3,
+		{"c", 0}: 4,
+	}
+
+	t.Run("keys", func(t *testing.T) {
+		expected := []key{{"a", 1}, {"a", 2}, {"b", 1}, {"c", 0}}
+		actual := aggregation.SortedKeys(key.Compare)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		reverse := func(a, b key) int {
+			return b.Compare(a)
+		}
+
+		expected := []int{4, 1, 2, 3}
+
+		actual := []int{}
+		for _, value := range aggregation.Sorted(reverse) {
+			actual = append(actual, value)
+		}
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		actual := []int{}
+		for _, value := range aggregation.Sorted(key.Compare) {
+			actual = append(actual, value)
+
+			break
+		}
+
+		assert.Equal(t, []int{3}, actual)
+	})
+}
+
+func TestKeyPair(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        twext.KeyPair[string, int]
+		b        twext.KeyPair[string, int]
+		expected int
+	}{
+		{
+			name:     "equal",
+			a:        twext.KeyPair[string, int]{"a", 1},
+			b:        twext.KeyPair[string, int]{"a", 1},
+			expected: 0,
+		},
+		{
+			name:     "first less",
+			a:        twext.KeyPair[string, int]{"a", 2},
+			b:        twext.KeyPair[string, int]{"b", 1},
+			expected: -1,
+		},
+		{
+			name:     "second greater",
+			a:        twext.KeyPair[string, int]{"a", 2},
+			b:        twext.KeyPair[string, int]{"a", 1},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.a.Compare(tt.b))
+
+			first, second := tt.a.Split()
+			assert.Equal(t, tt.a.First, first)
+			assert.Equal(t, tt.a.Second, second)
+		})
+	}
+}
+
+func TestAggregateComposite(t *testing.T) {
+	type key = twext.KeyPair[int, string]
+
+	entries := twext.Entries{
+		{ID: 1, Tags: []string{"a"}},
+		{ID: 2, Tags: []string{"a", "b"}},
+		{ID: 3, Tags: []string{"a"}},
+		{ID: 4},
+	}
+
+	actual := twext.AggregateComposite(
+		entries.All(),
+		func(e twext.Entry) key {
+			return key{len(e.Tags), strings.Join(e.Tags, ",")}
+		},
+		func(r []int, e twext.Entry) []int {
+			return append(r, e.ID)
+		},
+	)
+
+	expected := twext.CompositeAggregation[key, []int]{
+		{0, ""}:    {4},
+		{1, "a"}:   {1, 3},
+		{2, "a,b"}: {2},
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestAggregateFanOut(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  twext.Entries
+		keysFn   twext.AggregationKeysFunc[string]
+		expected twext.CompositeAggregation[string, int]
+	}{
+		{
+			name:    "empty",
+			entries: twext.Entries{},
+			keysFn: func(e twext.Entry) []string {
+				return e.Tags
+			},
+			expected: twext.CompositeAggregation[string, int]{},
+		},
+		{
+			name: "by tags",
+			entries: twext.Entries{
+				{Tags: []string{"a", "b", "c"}},
+				{Tags: []string{"a", "d"}},
+				{},
+				{Tags: []string{"c"}},
+			},
+			keysFn: func(e twext.Entry) []string {
+				return e.Tags
+			},
+			expected: twext.CompositeAggregation[string, int]{
+				"a": 2,
+				"b": 1,
+				"c": 2,
+				"d": 1,
+			},
+		},
+		{
+			name: "duplicate keys",
+			entries: twext.Entries{
+				{Tags: []string{"a", "b"}},
+			},
+			keysFn: func(_ twext.Entry) []string {
+				return []string{"x", "x"}
+			},
+			expected: twext.CompositeAggregation[string, int]{
+				"x": 2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := twext.AggregateFanOut(
+				tt.entries.All(),
+				tt.keysFn,
+				func(r int, _ twext.Entry) int {
+					return r + 1
+				},
+			)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestAggregation_Merge(t *testing.T) {
+	sum := func(a, b int) int {
+		return a + b
+	}
+
+	tests := []struct {
+		name     string
+		dst      twext.Aggregation[string, int]
+		src      twext.Aggregation[string, int]
+		expected twext.Aggregation[string, int]
+	}{
+		{
+			name:     "empty",
+			dst:      twext.Aggregation[string, int]{},
+			src:      twext.Aggregation[string, int]{},
+			expected: twext.Aggregation[string, int]{},
+		},
+		{
+			name:     "into empty",
+			dst:      twext.Aggregation[string, int]{},
+			src:      twext.Aggregation[string, int]{"a": 1},
+			expected: twext.Aggregation[string, int]{"a": 1},
+		},
+		{
+			name:     "from empty",
+			dst:      twext.Aggregation[string, int]{"a": 1},
+			src:      twext.Aggregation[string, int]{},
+			expected: twext.Aggregation[string, int]{"a": 1},
+		},
+		{
+			name:     "overlapping",
+			dst:      twext.Aggregation[string, int]{"a": 1, "b": 2},
+			src:      twext.Aggregation[string, int]{"b": 3, "c": 4},
+			expected: twext.Aggregation[string, int]{"a": 1, "b": 5, "c": 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.dst.Merge(tt.src, sum)
+			assert.Equal(t, tt.expected, tt.dst)
+		})
+	}
+}
+
+func TestCompositeAggregation_Merge(t *testing.T) {
+	type key = twext.KeyPair[string, int]
+
+	dst := twext.CompositeAggregation[key, []int]{
+		{"a", 1}: {1, 2},
+		{"b", 1}: {3},
+	}
+	src := twext.CompositeAggregation[key, []int]{
+		{"a", 1}: {4},
+		{"a", 2}: {5},
+	}
+
+	dst.Merge(src, func(a, b []int) []int {
+		return append(a, b...)
+	})
+
+	expected := twext.CompositeAggregation[key, []int]{
+		{"a", 1}: {1, 2, 4},
+		{"a", 2}: {5},
+		{"b", 1}: {3},
+	}
+	assert.Equal(t, expected, dst)
+}
+
+func syntheticEntries(n int) twext.EntryIterator {
+	start := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	tags := []string{"projA", "projB", "meeting", "support", "review"}
+
+	return func(yield func(twext.Entry) bool) {
+		for i := range n {
+			entryStart := start.Add(time.Duration(i) * 17 * time.Minute)
+			entry := twext.Entry{
+				ID:    n - i,
+				Start: twext.Time{entryStart},
+				End:   twext.Time{entryStart.Add(13 * time.Minute)},
+				Tags:  []string{tags[i%len(tags)]},
+			}
+
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func TestAggregateParallel(t *testing.T) {
+	keyFn := func(e twext.Entry) string {
+		return e.Start.Format(time.DateOnly)
+	}
+	valueFn := func(r []int, e twext.Entry) []int {
+		return append(r, e.ID)
+	}
+	mergeFn := func(a, b []int) []int {
+		return append(a, b...)
+	}
+
+	chunk := twext.DefaultChunkSize
+
+	for _, n := range []int{0, 1, chunk, 3*chunk + 7} {
+		entries := syntheticEntries(n)
+		expected := twext.Aggregate(entries, keyFn, valueFn)
+
+		for _, workers := range []int{0, 1, 4} {
+			name := strconv.Itoa(n) + "/" + strconv.Itoa(workers)
+			t.Run(name, func(t *testing.T) {
+				actual := twext.AggregateParallel(
+					entries,
+					workers,
+					keyFn,
+					valueFn,
+					mergeFn,
+				)
+				assert.Equal(t, expected, actual)
+			})
+		}
+	}
+}
+
+func BenchmarkAggregateParallel(b *testing.B) {
+	const numEntries = 1_000_000
+
+	entries := slices.Collect(syntheticEntries(numEntries))
+
+	keyFn := func(e twext.Entry) string {
+		return e.Start.Format(time.DateOnly)
+	}
+	valueFn := func(r time.Duration, e twext.Entry) time.Duration {
+		return r + e.Duration()
+	}
+	mergeFn := func(a, b time.Duration) time.Duration {
+		return a + b
+	}
+
+	expected := twext.Aggregate(slices.Values(entries), keyFn, valueFn)
+
+	b.Run("sequential", func(b *testing.B) {
+		var actual twext.Aggregation[string, time.Duration]
+
+		for b.Loop() {
+			actual = twext.Aggregate(slices.Values(entries), keyFn, valueFn)
+		}
+
+		require.Equal(b, expected, actual)
+	})
+
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run("parallel/"+strconv.Itoa(workers), func(b *testing.B) {
+			var actual twext.Aggregation[string, time.Duration]
+
+			for b.Loop() {
+				actual = twext.AggregateParallel(
+					slices.Values(entries),
+					workers,
+					keyFn,
+					valueFn,
+					mergeFn,
+				)
+			}
+
+			require.Equal(b, expected, actual)
+		})
+	}
+}
@@ -27,6 +27,9 @@ const (
 	ConfigKeyVerbose      ConfigKey = "verbose"
 	ConfigKeyDebug        ConfigKey = "debug"
 	ConfigKeyConfirmation ConfigKey = "confirmation"
+	ConfigKeyColor        ConfigKey = "color"
+	ConfigKeyReportStart  ConfigKey = "temp.report.start"
+	ConfigKeyReportEnd    ConfigKey = "temp.report.end"
 )
 
 // NewConfigKey composes a new [ConfigKey].
@@ -93,9 +96,130 @@ func (v ConfigValue) Duration() (time.Duration, error) {
 	return d, nil
 }
 
+// Location tries to parse the [ConfigValue] as IANA time zone name, like
+// "Europe/Berlin" or "UTC". The value "local" returns the system's local time
+// zone. It returns an error if the time zone is unknown.
+func (v ConfigValue) Location() (*time.Location, error) {
+	if strings.EqualFold(v.String(), "local") {
+		return time.Local, nil
+	}
+
+	location, err := time.LoadLocation(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("load location: %w", err)
+	}
+
+	return location, nil
+}
+
+// Time tries to parse the [ConfigValue] as timestamp in timewarrior format.
+// An empty [ConfigValue] results in the zero [time.Time].
+func (v ConfigValue) Time() (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := ParseTime(v.String())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.Time, nil
+}
+
 // Config is a collection of configuration directives.
 type Config map[ConfigKey]ConfigValue
 
+// ReportRange returns the time range the report was called for, as given by
+// timewarrior in [ConfigKeyReportStart] and [ConfigKeyReportEnd]. Start or
+// End are zero if the range is open on that side, like for ":all".
+func (c Config) ReportRange() (Interval, error) {
+	var (
+		interval Interval
+		err      error
+	)
+
+	interval.Start, err = ReadConfigValue(
+		c,
+		ConfigKeyReportStart,
+		"",
+		ConfigValue.Time,
+	)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	interval.End, err = ReadConfigValue(
+		c,
+		ConfigKeyReportEnd,
+		"",
+		ConfigValue.Time,
+	)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	return interval, nil
+}
+
+// ReportBounds returns the given report range limited to the past. If the
+// range is open at the end, it ends now. If it is open at the start, it starts
+// at [Midnight] of the day of the first entry in the given location.
+func ReportBounds(
+	reportRange Interval,
+	entries Entries,
+	location *time.Location,
+) Interval {
+	now := Now()
+	bounds := reportRange
+
+	if bounds.End.IsZero() || bounds.End.After(now) {
+		bounds.End = now
+	}
+
+	if bounds.Start.IsZero() {
+		for _, entry := range entries {
+			if bounds.Start.IsZero() || entry.Start.Before(bounds.Start) {
+				bounds.Start = entry.Start.Time
+			}
+		}
+
+		if !bounds.Start.IsZero() {
+			bounds.Start = Midnight(bounds.Start.In(location))
+		}
+	}
+
+	return bounds
+}
+
+// ReadConfigValue parses the value of the given key with the given parse
+// function. If the key is not present, the default value is parsed instead.
+//
+// The [ConfigValue] methods can be used as parse function in form of method
+// expressions, like ConfigValue.Duration.
+//
+//nolint:ireturn,nolintlint
+func ReadConfigValue[R any](
+	config Config,
+	key ConfigKey,
+	defValue ConfigValue,
+	parse func(ConfigValue) (R, error),
+) (R, error) {
+	value, exists := config[key]
+	if !exists {
+		value = defValue
+	}
+
+	result, err := parse(value)
+	if err != nil {
+		var zero R
+
+		return zero, fmt.Errorf("%s: %w", key, err)
+	}
+
+	return result, nil
+}
+
 type stringReader interface {
 	ReadString(delimiter byte) (string, error)
 }
@@ -6,6 +6,10 @@ package twext
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -127,3 +131,50 @@ func TestReadConfig(t *testing.T) {
 		})
 	}
 }
+
+func FuzzReadConfig(f *testing.F) {
+	for _, input := range testdataInputs(f) {
+		f.Add(input)
+	}
+
+	f.Add("key: value\nother: a: b\n")
+	f.Add(": \n\n")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		config, err := readConfig(bytes.NewBufferString(input))
+		if err != nil {
+			return
+		}
+
+		require.NotEmpty(t, config)
+
+		serialized := &strings.Builder{}
+		for key, value := range config {
+			_, _ = fmt.Fprintf(serialized, "%s: %s\n", key, value)
+		}
+
+		reparsed, err := readConfig(bytes.NewBufferString(serialized.String()))
+		require.NoError(t, err)
+		assert.Equal(t, config, reparsed)
+	})
+}
+
+// testdataInputs returns the content of all input files in the testdata
+// directory.
+func testdataInputs(tb testing.TB) []string {
+	tb.Helper()
+
+	paths, err := filepath.Glob(filepath.Join("testdata", "input_*.txt"))
+	require.NoError(tb, err)
+
+	inputs := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		require.NoError(tb, err)
+
+		inputs = append(inputs, string(data))
+	}
+
+	return inputs
+}
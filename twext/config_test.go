@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -236,3 +237,208 @@ func TestConfigValueDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValueLocation(t *testing.T) {
+	tests := []struct {
+		input            twext.ConfigValue
+		expectedLocation string
+		local            bool
+		invalid          bool
+	}{
+		{
+			input:            "UTC",
+			expectedLocation: "UTC",
+		},
+		{
+			input:            "Europe/Berlin",
+			expectedLocation: "Europe/Berlin",
+		},
+		{
+			input: "local",
+			local: true,
+		},
+		{
+			input: "Local",
+			local: true,
+		},
+		{
+			input:   "Mars/Olympus_Mons",
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actualLocation, err := tt.input.Location()
+
+			if tt.invalid {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			// The name of the local time zone depends on the TZ environment
+			// variable, so compare the identity instead.
+			if tt.local {
+				assert.Same(t, time.Local, actualLocation)
+
+				return
+			}
+
+			assert.Equal(t, tt.expectedLocation, actualLocation.String())
+		})
+	}
+}
+
+func TestConfigValueTime(t *testing.T) {
+	tests := []struct {
+		input    twext.ConfigValue
+		expected time.Time
+		invalid  bool
+	}{
+		{
+			input: "",
+		},
+		{
+			input:    "20240701T000000Z",
+			expected: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:   "2024-07-01",
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input.String(), func(t *testing.T) {
+			actual, err := tt.input.Time()
+
+			if tt.invalid {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(actual), actual)
+		})
+	}
+}
+
+func TestConfigReportRange(t *testing.T) {
+	config := twext.Config{
+		twext.ConfigKeyReportStart: "20240701T000000Z",
+		twext.ConfigKeyReportEnd:   "",
+	}
+
+	actual, err := config.ReportRange()
+	require.NoError(t, err)
+	assert.Equal(t, 2024, actual.Start.Year())
+	assert.True(t, actual.End.IsZero())
+
+	config[twext.ConfigKeyReportEnd] = "tomorrow"
+
+	_, err = config.ReportRange()
+	require.ErrorContains(t, err, "temp.report.end")
+}
+
+func TestReportBounds(t *testing.T) {
+	twexttest.FixedClock(t, at(18, 0))
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	entries := twext.Entries{
+		{Start: twext.Time{Time: at(9, 0)}, End: twext.Time{Time: at(10, 0)}},
+		{Start: twext.Time{Time: at(7, 0)}, End: twext.Time{Time: at(8, 0)}},
+	}
+
+	tests := []struct {
+		name        string
+		reportRange twext.Interval
+		entries     twext.Entries
+		expected    twext.Interval
+	}{
+		{
+			name:        "closed range",
+			reportRange: interval(6, 0, 12, 0),
+			entries:     entries,
+			expected:    interval(6, 0, 12, 0),
+		},
+		{
+			name:        "future end",
+			reportRange: interval(6, 0, 20, 0),
+			entries:     entries,
+			expected:    interval(6, 0, 18, 0),
+		},
+		{
+			name:     "open range",
+			entries:  entries,
+			expected: twext.Interval{Start: at(-2, 0), End: at(18, 0)},
+		},
+		{
+			name:     "open range without entries",
+			expected: twext.Interval{End: at(18, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := twext.ReportBounds(tt.reportRange, tt.entries, berlin)
+			assert.True(t, tt.expected.Start.Equal(actual.Start), actual)
+			assert.True(t, tt.expected.End.Equal(actual.End), actual)
+		})
+	}
+}
+
+func TestReadConfigValue(t *testing.T) {
+	config := twext.Config{
+		"a.duration": "5m",
+		"a.broken":   "five",
+	}
+
+	tests := []struct {
+		name     string
+		key      twext.ConfigKey
+		expected time.Duration
+		errorMsg string
+	}{
+		{
+			name:     "present",
+			key:      "a.duration",
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "default",
+			key:      "a.missing",
+			expected: time.Hour,
+		},
+		{
+			name:     "invalid",
+			key:      "a.broken",
+			errorMsg: "a.broken: parse: time: invalid duration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := twext.ReadConfigValue(
+				config,
+				tt.key,
+				"1h",
+				twext.ConfigValue.Duration,
+			)
+
+			if tt.errorMsg != "" {
+				require.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -15,10 +15,11 @@ import (
 
 // Entry is a timewarrior entry that covers a single recorded time interval.
 type Entry struct {
-	ID    int      `json:"id"`
-	Start Time     `json:"start"`
-	End   Time     `json:"end"`
-	Tags  []string `json:"tags,omitempty"`
+	ID         int      `json:"id"`
+	Start      Time     `json:"start"`
+	End        Time     `json:"end"`
+	Tags       []string `json:"tags,omitempty"`
+	Annotation string   `json:"annotation,omitempty"`
 }
 
 // Duration calculates the duration of the [Entry].
@@ -36,11 +37,12 @@ func (e *Entry) Duration() time.Duration {
 
 // CurrentEnd calculates the actual end of the [Entry].
 //
-// If the [Entry] is still active, the current time is returned.
+// If the [Entry] is still active, the current time as returned by [Now] is
+// returned.
 // Otherwise, the recorded time is returned.
 func (e *Entry) CurrentEnd() *Time {
 	if e.IsActive() {
-		return &Time{time.Now()}
+		return &Time{Now()}
 	}
 
 	return &e.End
@@ -51,23 +53,58 @@ func (e *Entry) IsActive() bool {
 	return e.End.IsZero()
 }
 
+// In returns a copy of the [Entry] with start and end time converted into
+// the given location.
+func (e *Entry) In(location *time.Location) Entry {
+	entry := *e
+	entry.Start = Time{e.Start.In(location)}
+
+	if !e.End.IsZero() {
+		entry.End = Time{e.End.In(location)}
+	}
+
+	return entry
+}
+
+// InLocation returns an [EntryIterator] that converts all entries into the
+// given location. Timewarrior reports all times in UTC, so entries should be
+// converted into the user's time zone before they are grouped by date.
+func InLocation(entries EntryIterator, location *time.Location) EntryIterator {
+	return func(yield func(Entry) bool) {
+		for entry := range entries {
+			if !yield(entry.In(location)) {
+				return
+			}
+		}
+	}
+}
+
 // SplitIntoDays splits the entry in multiple entries, one per day, at the
 // given split time.
 //
 // It splits the [Entry] into days at the given clock [time.Time] of each day.
-// The date parts of that given split [time.Time] are ignored. To split at
-// midnight, just pass an empty value time.Time{}.
+// The date parts and the location of that given split [time.Time] are
+// ignored. To split at midnight, just pass an empty value time.Time{}.
+//
+// Days are determined in the location of the entry's start time. Use
+// [Entry.In] to split in another time zone. Days with daylight saving time
+// transitions are 23 or 25 hours long. If the split clock does not exist on
+// such a day, it is normalized like [time.Date] does.
 func SplitIntoDays(entry Entry, splitClock time.Time) EntryIterator {
 	return func(yield func(Entry) bool) {
-		splitTime := setClock(entry.Start.Time, splitClock)
+		day := entry.Start.Time
+		splitTime := setClock(day, splitClock)
+
 		if entry.Start.Compare(splitTime) >= 0 {
-			splitTime = splitTime.AddDate(0, 0, 1)
+			day = nextDay(day)
+			splitTime = setClock(day, splitClock)
 		}
 
 		for entry.CurrentEnd().Compare(splitTime) > 0 {
 			before := entry
 			before.End = Time{splitTime}
-			splitTime = splitTime.AddDate(0, 0, 1)
+			day = nextDay(day)
+			splitTime = setClock(day, splitClock)
 			entry.Start = before.End
 
 			if !yield(before) {
@@ -81,6 +118,22 @@ func SplitIntoDays(entry Entry, splitClock time.Time) EntryIterator {
 	}
 }
 
+// SplitAtMidnight returns an [EntryIterator] that splits all entries into days
+// at midnight, like [SplitIntoDays] does for a single [Entry]. Days are
+// determined in the location of each entry's start time, so convert the
+// entries with [InLocation] first to split them in another time zone.
+func SplitAtMidnight(entries EntryIterator) EntryIterator {
+	return func(yield func(Entry) bool) {
+		for entry := range entries {
+			for e := range SplitIntoDays(entry, time.Time{}) {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Entries is a list of [Entry]s.
 type Entries []Entry
 
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzReadEntries(f *testing.F) {
+	for _, input := range testdataInputs(f) {
+		_, entries, _ := strings.Cut(input, "\n\n")
+		f.Add(entries)
+	}
+
+	f.Add(`[{"id":1,"start":"20240630T144010Z","tags":["a","b"]}]`)
+	f.Add(`[{"id":1,"start":null,"end":""}]`)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		entries, err := readEntries(strings.NewReader(input))
+		if err != nil {
+			return
+		}
+
+		for _, entry := range entries {
+			for _, ts := range []Time{entry.Start, entry.End} {
+				if ts.IsZero() {
+					continue
+				}
+
+				reparsed, err := ParseTime(ts.Format(DateFmt))
+				require.NoError(t, err)
+				assert.True(t, ts.Equal(reparsed.Time))
+			}
+
+			assert.GreaterOrEqual(t, entry.Duration(), time.Duration(0))
+		}
+	})
+}
@@ -5,6 +5,8 @@
 package twext_test
 
 import (
+	"fmt"
+	"math/rand/v2"
 	"slices"
 	"testing"
 	"time"
@@ -206,6 +208,229 @@ func TestEntry_SplitIntoDays(t *testing.T) {
 	}
 }
 
+func TestEntry_SplitIntoDays_DST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    twext.Entry
+		split    time.Time
+		expected [][2]string
+	}{
+		{
+			name: "23 hour day",
+			input: twext.Entry{
+				Start: twext.MustParseTime("20240330T200000Z"),
+				End:   twext.MustParseTime("20240331T230000Z"),
+			},
+			expected: [][2]string{
+				{"2024-03-30T21:00:00+01:00", "2024-03-31T00:00:00+01:00"},
+				{"2024-03-31T00:00:00+01:00", "2024-04-01T00:00:00+02:00"},
+				{"2024-04-01T00:00:00+02:00", "2024-04-01T01:00:00+02:00"},
+			},
+		},
+		{
+			name: "25 hour day",
+			input: twext.Entry{
+				Start: twext.MustParseTime("20241026T200000Z"),
+				End:   twext.MustParseTime("20241027T233000Z"),
+			},
+			expected: [][2]string{
+				{"2024-10-26T22:00:00+02:00", "2024-10-27T00:00:00+02:00"},
+				{"2024-10-27T00:00:00+02:00", "2024-10-28T00:00:00+01:00"},
+				{"2024-10-28T00:00:00+01:00", "2024-10-28T00:30:00+01:00"},
+			},
+		},
+		{
+			name: "split clock skipped by transition",
+			input: twext.Entry{
+				Start: twext.MustParseTime("20240329T120000Z"),
+				End:   twext.MustParseTime("20240401T120000Z"),
+			},
+			split: time.Date(0, 0, 0, 2, 30, 0, 0, time.UTC),
+			expected: [][2]string{
+				{"2024-03-29T13:00:00+01:00", "2024-03-30T02:30:00+01:00"},
+				{"2024-03-30T02:30:00+01:00", "2024-03-31T03:30:00+02:00"},
+				{"2024-03-31T03:30:00+02:00", "2024-04-01T02:30:00+02:00"},
+				{"2024-04-01T02:30:00+02:00", "2024-04-01T14:00:00+02:00"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				actual [][2]string
+				sum    time.Duration
+			)
+
+			for entry := range twext.SplitIntoDays(
+				tt.input.In(berlin),
+				tt.split,
+			) {
+				actual = append(actual, [2]string{
+					entry.Start.Format(time.RFC3339),
+					entry.End.Format(time.RFC3339),
+				})
+				sum += entry.Duration()
+			}
+
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.input.Duration(), sum)
+		})
+	}
+}
+
+func TestSplitAtMidnight(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    twext.Entries
+		expected []twext.Entry
+	}{
+		{
+			name:  "empty",
+			input: twext.Entries{},
+		},
+		{
+			name: "only single-day entries",
+			input: twext.Entries{
+				twext.Entry{
+					Start: twext.MustParseTime("20100203T101530Z"),
+					End:   twext.MustParseTime("20100203T142537Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100204T092755Z"),
+					End:   twext.MustParseTime("20100204T163211Z"),
+				},
+			},
+			expected: twext.Entries{
+				twext.Entry{
+					Start: twext.MustParseTime("20100203T101530Z"),
+					End:   twext.MustParseTime("20100203T142537Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100204T092755Z"),
+					End:   twext.MustParseTime("20100204T163211Z"),
+				},
+			},
+		},
+		{
+			name: "multi-day entries",
+			input: twext.Entries{
+				twext.Entry{
+					Start: twext.MustParseTime("20100203T101530Z"),
+					End:   twext.MustParseTime("20100204T142537Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100205T092755Z"),
+					End:   twext.MustParseTime("20100207T163211Z"),
+				},
+			},
+			expected: twext.Entries{
+				twext.Entry{
+					Start: twext.MustParseTime("20100203T101530Z"),
+					End:   twext.MustParseTime("20100204T000000Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100204T000000Z"),
+					End:   twext.MustParseTime("20100204T142537Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100205T092755Z"),
+					End:   twext.MustParseTime("20100206T000000Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100206T000000Z"),
+					End:   twext.MustParseTime("20100207T000000Z"),
+				},
+				twext.Entry{
+					Start: twext.MustParseTime("20100207T000000Z"),
+					End:   twext.MustParseTime("20100207T163211Z"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sumDurations := func(e twext.EntryIterator) time.Duration {
+				var sum time.Duration
+				for entry := range e {
+					sum += entry.Duration()
+				}
+
+				return sum
+			}
+
+			entries := twext.SplitAtMidnight(tt.input.All())
+
+			assert.Equal(t, tt.expected, slices.Collect(entries),
+				"entries should be split correctly")
+
+			expectedTotal := sumDurations(tt.input.All())
+			actualTotal := sumDurations(entries)
+
+			assert.Equal(t, expectedTotal, actualTotal,
+				"total duration should be the same")
+		})
+	}
+}
+
+func TestEntry_In(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	t.Run("closed", func(t *testing.T) {
+		entry := twext.Entry{
+			ID:    3,
+			Start: twext.MustParseTime("20240629T223000Z"),
+			End:   twext.MustParseTime("20240629T233000Z"),
+			Tags:  []string{"a"},
+		}
+
+		actual := entry.In(berlin)
+		assert.Equal(t, entry.ID, actual.ID)
+		assert.Equal(t, entry.Tags, actual.Tags)
+		assert.True(t, entry.Start.Equal(actual.Start.Time))
+		assert.True(t, entry.End.Equal(actual.End.Time))
+		assert.Equal(t, berlin, actual.Start.Location())
+		assert.Equal(t, "2024-06-30", actual.Start.Format(time.DateOnly))
+		assert.Equal(t, "2024-06-30", actual.End.Format(time.DateOnly))
+	})
+
+	t.Run("active", func(t *testing.T) {
+		entry := twext.Entry{
+			Start: twext.MustParseTime("20240629T223000Z"),
+		}
+
+		actual := entry.In(berlin)
+		assert.True(t, actual.IsActive())
+		assert.Equal(t, time.Time{}, actual.End.Time)
+	})
+}
+
+func TestInLocation(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	entries := twext.Entries{
+		{ID: 2, Start: twext.MustParseTime("20240629T223000Z")},
+		{ID: 1, Start: twext.MustParseTime("20240630T080000Z")},
+	}
+
+	actual := []string{}
+	for entry := range twext.InLocation(entries.All(), berlin) {
+		actual = append(actual, entry.Start.Format(time.DateTime))
+
+		if entry.ID == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"2024-06-30 00:30:00"}, actual)
+}
+
 func TestEntries_Filter(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -288,3 +513,148 @@ func TestEntries_Filter(t *testing.T) {
 		})
 	}
 }
+
+var propertyTestLocations = []string{
+	"UTC",
+	"Europe/Berlin",
+	"America/New_York",
+	"America/Santiago",
+	"Australia/Lord_Howe",
+}
+
+// checkSplitIntoDaysProperties checks the invariants of [twext.SplitIntoDays]
+// for a closed entry: the durations of the pieces sum up to the duration of
+// the entry, the pieces are contiguous and each piece stays within one day.
+func checkSplitIntoDaysProperties(
+	t *testing.T,
+	entry twext.Entry,
+	splitClock time.Time,
+) {
+	t.Helper()
+
+	pieces := slices.Collect(twext.SplitIntoDays(entry, splitClock))
+	require.NotEmpty(t, pieces)
+
+	var sum time.Duration
+
+	for idx, piece := range pieces {
+		sum += piece.Duration()
+
+		if idx == 0 {
+			assert.True(t, piece.Start.Equal(entry.Start.Time), "first start")
+		} else {
+			previous := pieces[idx-1]
+			assert.True(t, piece.Start.Equal(previous.End.Time), "contiguous")
+		}
+
+		year, month, day := piece.Start.Date()
+		hour, minute, sec := splitClock.Clock()
+		splitAt := func(day int) time.Time {
+			return time.Date(
+				year, month, day,
+				hour, minute, sec, splitClock.Nanosecond(),
+				piece.Start.Location(),
+			)
+		}
+
+		nextSplit := splitAt(day)
+		if !piece.Start.Before(nextSplit) {
+			nextSplit = splitAt(day + 1)
+		}
+
+		assert.False(t, piece.End.After(nextSplit), "within one day")
+
+		if idx < len(pieces)-1 {
+			assert.True(t, piece.End.Equal(nextSplit), "split at clock")
+		}
+	}
+
+	last := pieces[len(pieces)-1]
+	assert.True(t, last.End.Equal(entry.End.Time), "last end")
+	assert.Equal(t, entry.Duration(), sum, "duration sum")
+}
+
+func newPropertyTestEntry(
+	t *testing.T,
+	location string,
+	startUnix int64,
+	duration time.Duration,
+) twext.Entry {
+	t.Helper()
+
+	loc, err := time.LoadLocation(location)
+	require.NoError(t, err)
+
+	start := time.Unix(startUnix, 0).In(loc)
+
+	return twext.Entry{
+		Start: twext.Time{Time: start},
+		End:   twext.Time{Time: start.Add(duration)},
+	}
+}
+
+func TestSplitIntoDays_Properties(t *testing.T) {
+	const iterations = 2000
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+	minStart := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxStart := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	for range iterations {
+		locationIdx := rnd.IntN(len(propertyTestLocations))
+		location := propertyTestLocations[locationIdx]
+		startUnix := minStart + rnd.Int64N(maxStart-minStart)
+		duration := time.Duration(rnd.Int64N(int64(120 * time.Hour)))
+		splitClock := time.Date(
+			0, 1, 1,
+			rnd.IntN(24), rnd.IntN(60), rnd.IntN(60), 0,
+			time.UTC,
+		)
+
+		entry := newPropertyTestEntry(t, location, startUnix, duration)
+		name := fmt.Sprintf("%s/%s/%s/%s",
+			location,
+			entry.Start.Format(time.RFC3339),
+			duration,
+			splitClock.Format(time.TimeOnly),
+		)
+
+		t.Run(name, func(t *testing.T) {
+			checkSplitIntoDaysProperties(t, entry, splitClock)
+		})
+	}
+}
+
+func FuzzSplitIntoDays(f *testing.F) {
+	f.Add(uint8(1), int64(1711832400), int64(90000), uint32(0))
+	f.Add(uint8(1), int64(1729972800), int64(100000), uint32(9000))
+	f.Add(uint8(0), int64(1265189730), int64(-3600), uint32(43200))
+
+	f.Fuzz(func(
+		t *testing.T,
+		locationIdx uint8,
+		startUnix int64,
+		durationSec int64,
+		splitSec uint32,
+	) {
+		const maxDuration = 400 * 24 * 60 * 60
+
+		// Limit to sensible values to keep the runtime bounded.
+		startUnix %= 1 << 36
+		durationSec %= maxDuration
+		splitSec %= 24 * 60 * 60
+
+		location := propertyTestLocations[int(locationIdx)%len(
+			propertyTestLocations,
+		)]
+		entry := newPropertyTestEntry(
+			t,
+			location,
+			startUnix,
+			time.Duration(durationSec)*time.Second,
+		)
+		splitClock := time.Date(0, 1, 1, 0, 0, int(splitSec), 0, time.UTC)
+
+		checkSplitIntoDaysProperties(t, entry, splitClock)
+	})
+}
@@ -6,6 +6,7 @@ package twext_test
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/aibor/timewarrior-extensions/twext"
@@ -54,3 +55,43 @@ reports.day.axis: internal
 	// 29: 1
 	// 30: 2
 }
+
+func ExampleReport() {
+	stdin := strings.NewReader(`verbose: on
+example.unit: m
+
+[
+{"id":2,"start":"20240630T143940Z","end":"20240630T144340Z"},
+{"id":1,"start":"20240630T144010Z","end":"20240630T145010Z"}
+]
+`)
+
+	report := twext.Report[string]{
+		Name: "example",
+		ParseConfig: func(config twext.Config) (string, error) {
+			return config["example.unit"].String(), nil
+		},
+		Render: func(
+			env twext.Env,
+			unit string,
+			entries twext.Entries,
+		) error {
+			for _, entry := range entries {
+				minutes := entry.Duration().Minutes()
+				fmt.Fprintf(env.Out, "%d: %.0f%s\n", entry.ID, minutes, unit)
+			}
+
+			return nil
+		},
+	}
+
+	// In the main function of the extension, call report.Main() instead.
+	err := report.Run(stdin, os.Stdout, os.Stderr)
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// 2: 4m
+	// 1: 10m
+}
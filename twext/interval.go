@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext
+
+import (
+	"slices"
+	"time"
+)
+
+// Interval is a time range from Start to End. End is exclusive.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the [Interval]. It is 0 if End is not after
+// Start.
+func (i Interval) Duration() time.Duration {
+	if !i.End.After(i.Start) {
+		return 0
+	}
+
+	return i.End.Sub(i.Start)
+}
+
+// Interval returns the [Interval] covered by the [Entry]. If the [Entry] is
+// still active, the current time as returned by [Now] is used as end.
+func (e *Entry) Interval() Interval {
+	return Interval{Start: e.Start.Time, End: e.CurrentEnd().Time}
+}
+
+// ClipEntries returns an [EntryIterator] with the entries restricted to the
+// bounds. Entries outside of the bounds are dropped. A zero Start or End of
+// the bounds leaves that side open.
+//
+// Timewarrior passes all entries that intersect the report range, so entries
+// may start before or end after it. Active entries that are cut at the end of
+// the bounds are not active anymore.
+func ClipEntries(entries EntryIterator, bounds Interval) EntryIterator {
+	return func(yield func(Entry) bool) {
+		for entry := range entries {
+			if !bounds.Start.IsZero() && entry.Start.Before(bounds.Start) {
+				entry.Start = Time{bounds.Start}
+			}
+
+			if !bounds.End.IsZero() && entry.CurrentEnd().After(bounds.End) {
+				entry.End = Time{bounds.End}
+			}
+
+			if entry.Duration() == 0 {
+				continue
+			}
+
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// Gaps returns the parts of the bounds that are not covered by any of the
+// given intervals, in chronological order. The intervals may be unsorted and
+// may overlap. Empty intervals are ignored.
+func Gaps(intervals []Interval, bounds Interval) []Interval {
+	sorted := slices.SortedFunc(
+		slices.Values(intervals),
+		func(a, b Interval) int {
+			return a.Start.Compare(b.Start)
+		},
+	)
+
+	var gaps []Interval
+
+	cursor := bounds.Start
+
+	for _, interval := range sorted {
+		if interval.Duration() == 0 || !interval.End.After(cursor) {
+			continue
+		}
+
+		if !interval.Start.Before(bounds.End) {
+			break
+		}
+
+		if interval.Start.After(cursor) {
+			gaps = append(gaps, Interval{Start: cursor, End: interval.Start})
+		}
+
+		cursor = interval.End
+	}
+
+	if cursor.Before(bounds.End) {
+		gaps = append(gaps, Interval{Start: cursor, End: bounds.End})
+	}
+
+	return gaps
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2024, 7, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func interval(fromHour, fromMinute, toHour, toMinute int) twext.Interval {
+	return twext.Interval{
+		Start: at(fromHour, fromMinute),
+		End:   at(toHour, toMinute),
+	}
+}
+
+func TestInterval_Duration(t *testing.T) {
+	assert.Equal(t, 90*time.Minute, interval(8, 0, 9, 30).Duration())
+	assert.Zero(t, interval(9, 0, 9, 0).Duration())
+	assert.Zero(t, interval(9, 30, 8, 0).Duration())
+}
+
+func TestEntry_Interval(t *testing.T) {
+	twexttest.FixedClock(t, at(12, 0))
+
+	closed := twext.Entry{
+		Start: twext.Time{Time: at(8, 0)},
+		End:   twext.Time{Time: at(9, 0)},
+	}
+	assert.Equal(t, interval(8, 0, 9, 0), closed.Interval())
+
+	active := twext.Entry{Start: twext.Time{Time: at(10, 0)}}
+	assert.Equal(t, interval(10, 0, 12, 0), active.Interval())
+}
+
+func TestClipEntries(t *testing.T) {
+	twexttest.FixedClock(t, at(18, 0))
+
+	entry := func(id int, start, end time.Time) twext.Entry {
+		return twext.Entry{
+			ID:    id,
+			Start: twext.Time{Time: start},
+			End:   twext.Time{Time: end},
+		}
+	}
+
+	entries := twext.Entries{
+		entry(5, at(6, 0), at(7, 0)),
+		entry(4, at(7, 30), at(9, 30)),
+		entry(3, at(10, 0), at(11, 0)),
+		entry(2, at(16, 0), at(17, 30)),
+		entry(1, at(16, 30), time.Time{}),
+	}
+
+	tests := []struct {
+		name     string
+		bounds   twext.Interval
+		expected twext.Entries
+	}{
+		{
+			name:     "open",
+			expected: entries,
+		},
+		{
+			name:   "closed",
+			bounds: interval(9, 0, 17, 0),
+			expected: twext.Entries{
+				entry(4, at(9, 0), at(9, 30)),
+				entry(3, at(10, 0), at(11, 0)),
+				entry(2, at(16, 0), at(17, 0)),
+				entry(1, at(16, 30), at(17, 0)),
+			},
+		},
+		{
+			name:   "open end",
+			bounds: twext.Interval{Start: at(16, 45)},
+			expected: twext.Entries{
+				entry(2, at(16, 45), at(17, 30)),
+				entry(1, at(16, 45), time.Time{}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := twext.ClipEntries(entries.All(), tt.bounds)
+			assert.Equal(t, tt.expected, twext.Entries(slices.Collect(actual)))
+		})
+	}
+}
+
+func TestGaps(t *testing.T) {
+	bounds := interval(9, 0, 17, 0)
+
+	tests := []struct {
+		name      string
+		intervals []twext.Interval
+		expected  []twext.Interval
+	}{
+		{
+			name:     "no intervals",
+			expected: []twext.Interval{bounds},
+		},
+		{
+			name:      "fully covered",
+			intervals: []twext.Interval{interval(8, 0, 18, 0)},
+		},
+		{
+			name: "gaps in between",
+			intervals: []twext.Interval{
+				interval(13, 10, 14, 0),
+				interval(9, 0, 12, 0),
+				interval(14, 50, 17, 0),
+			},
+			expected: []twext.Interval{
+				interval(12, 0, 13, 10),
+				interval(14, 0, 14, 50),
+			},
+		},
+		{
+			name: "overlapping and nested",
+			intervals: []twext.Interval{
+				interval(9, 30, 12, 0),
+				interval(10, 0, 11, 0),
+				interval(11, 30, 13, 0),
+			},
+			expected: []twext.Interval{
+				interval(9, 0, 9, 30),
+				interval(13, 0, 17, 0),
+			},
+		},
+		{
+			name: "outside of bounds",
+			intervals: []twext.Interval{
+				interval(7, 0, 8, 0),
+				interval(18, 0, 19, 0),
+			},
+			expected: []twext.Interval{bounds},
+		},
+		{
+			name: "empty and negative intervals",
+			intervals: []twext.Interval{
+				interval(10, 0, 10, 0),
+				interval(12, 0, 11, 0),
+			},
+			expected: []twext.Interval{bounds},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, twext.Gaps(tt.intervals, bounds))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext
+
+import (
+	"maps"
+	"slices"
+)
+
+// PivotTable is a two-dimensional table of values with rows of type R and
+// columns of type C. It is created from a [CompositeAggregation] by [Pivot].
+type PivotTable[R, C comparable, V any] map[R]map[C]V
+
+// Pivot turns the given [CompositeAggregation] into a [PivotTable].
+//
+// The split function returns the row and the column for a key. It must map
+// distinct keys to distinct cells. [KeyPair.Split] can be used for
+// [KeyPair] keys in the form of the method expression KeyPair[A, B].Split.
+func Pivot[K, R, C comparable, V any](
+	aggregation CompositeAggregation[K, V],
+	split func(K) (R, C),
+) PivotTable[R, C, V] {
+	table := PivotTable[R, C, V]{}
+
+	for key, value := range aggregation {
+		row, column := split(key)
+		if table[row] == nil {
+			table[row] = map[C]V{}
+		}
+
+		table[row][column] = value
+	}
+
+	return table
+}
+
+// Rows returns the rows of the [PivotTable] sorted by the given
+// [KeyCompareFunc].
+func (t PivotTable[R, C, V]) Rows(compare KeyCompareFunc[R]) []R {
+	return slices.SortedFunc(maps.Keys(t), compare)
+}
+
+// Columns returns all columns present in any row of the [PivotTable] sorted
+// by the given [KeyCompareFunc].
+func (t PivotTable[R, C, V]) Columns(compare KeyCompareFunc[C]) []C {
+	columns := map[C]struct{}{}
+
+	for _, row := range t {
+		for column := range row {
+			columns[column] = struct{}{}
+		}
+	}
+
+	return slices.SortedFunc(maps.Keys(columns), compare)
+}
+
+// Cell returns the value for the given row and column. The second return
+// value reports if the cell exists.
+func (t PivotTable[R, C, V]) Cell(row R, column C) (V, bool) {
+	value, exists := t[row][column]
+
+	return value, exists
+}
+
+// RowTotals reduces each row to a single value with the given function.
+// The cells are visited in no particular order, so the function must be
+// commutative, like a sum.
+func (t PivotTable[R, C, V]) RowTotals(reduce func(V, V) V) map[R]V {
+	totals := make(map[R]V, len(t))
+
+	for row, columns := range t {
+		for _, value := range columns {
+			totals[row] = reduce(totals[row], value)
+		}
+	}
+
+	return totals
+}
+
+// ColumnTotals reduces each column to a single value with the given function.
+// Like for [PivotTable.RowTotals], the function must be commutative.
+func (t PivotTable[R, C, V]) ColumnTotals(reduce func(V, V) V) map[C]V {
+	totals := map[C]V{}
+
+	for _, columns := range t {
+		for column, value := range columns {
+			totals[column] = reduce(totals[column], value)
+		}
+	}
+
+	return totals
+}
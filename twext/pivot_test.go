@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPivot(t *testing.T) {
+	type key = twext.KeyPair[string, int]
+
+	aggregation := twext.CompositeAggregation[key, int]{
+		{"b", 1}: 1,
+		{"a", 2}: 2,
+		{"a", 1}: 3,
+		{"c", 3}: 4,
+	}
+
+	table := twext.Pivot(aggregation, key.Split)
+
+	expected := twext.PivotTable[string, int, int]{
+		"a": {1: 3, 2: 2},
+		"b": {1: 1},
+		"c": {3: 4},
+	}
+	assert.Equal(t, expected, table)
+
+	t.Run("rows", func(t *testing.T) {
+		expected := []string{"a", "b", "c"}
+		assert.Equal(t, expected, table.Rows(cmp.Compare[string]))
+	})
+
+	t.Run("columns", func(t *testing.T) {
+		expected := []int{1, 2, 3}
+		assert.Equal(t, expected, table.Columns(cmp.Compare[int]))
+	})
+
+	t.Run("cell", func(t *testing.T) {
+		value, exists := table.Cell("a", 2)
+		assert.True(t, exists)
+		assert.Equal(t, 2, value)
+
+		value, exists = table.Cell("b", 3)
+		assert.False(t, exists)
+		assert.Zero(t, value)
+
+		_, exists = table.Cell("z", 1)
+		assert.False(t, exists)
+	})
+
+	sum := func(a, b int) int {
+		return a + b
+	}
+
+	t.Run("row totals", func(t *testing.T) {
+		expected := map[string]int{"a": 5, "b": 1, "c": 4}
+		assert.Equal(t, expected, table.RowTotals(sum))
+	})
+
+	t.Run("column totals", func(t *testing.T) {
+		expected := map[int]int{1: 4, 2: 2, 3: 4}
+		assert.Equal(t, expected, table.ColumnTotals(sum))
+	})
+}
+
+func TestPivot_Empty(t *testing.T) {
+	table := twext.Pivot(
+		twext.CompositeAggregation[twext.KeyPair[int, int], int]{},
+		twext.KeyPair[int, int].Split,
+	)
+
+	assert.Empty(t, table.Rows(cmp.Compare[int]))
+	assert.Empty(t, table.Columns(cmp.Compare[int]))
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime/debug"
+	"strconv"
+)
+
+// ExitStatusError is returned by Render to make [Report.Main] exit with the
+// given status without printing an error message. It is meant for reports
+// that already describe the failure in their output, like a linter that
+// found problems.
+type ExitStatusError int
+
+// Error returns the exit status as message.
+func (e ExitStatusError) Error() string {
+	return "exit status " + strconv.Itoa(int(e))
+}
+
+// Env is the environment a [Report] is rendered in.
+type Env struct {
+	// Out receives the report output.
+	Out io.Writer
+	// Err receives warnings and diagnostic output.
+	Err io.Writer
+	// Debug is true if the "debug" config key is enabled.
+	Debug bool
+	// Verbose is true if the "verbose" config key is enabled.
+	Verbose bool
+}
+
+// Report is a timewarrior report implemented by an extension.
+//
+// The config type C holds the report specific configuration. It is created
+// by ParseConfig from the config section of the input and passed to Render
+// together with the entries.
+//
+// Call [Report.Main] from the extension's main function. It takes care of
+// reading the input, setting up debug logging, error handling and the exit
+// status.
+type Report[C any] struct {
+	// Name is the name of the report. It is used as prefix for debug output.
+	Name string
+	// ParseConfig parses the report specific config from the config section.
+	ParseConfig func(config Config) (C, error)
+	// Render writes the report for the given entries to [Env.Out].
+	Render func(env Env, cfg C, entries Entries) error
+}
+
+// Run runs the [Report] with the given input and outputs.
+//
+// It reads the config section from the input and sets up the standard
+// logger. If the "debug" config key is enabled, log output is written to
+// errOut prefixed by the report name. Otherwise, it is discarded. Then the
+// config is parsed and the entries are read and rendered.
+//
+// Panics in Render are recovered and returned as error. An
+// [ExitStatusError] returned by Render is returned as is.
+func (r Report[C]) Run(in io.ReadSeeker, out, errOut io.Writer) error {
+	reader := NewReader(in)
+
+	config, err := reader.ReadConfig()
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	env := Env{
+		Out:     out,
+		Err:     errOut,
+		Debug:   config[ConfigKeyDebug].Bool(),
+		Verbose: config[ConfigKeyVerbose].Bool(),
+	}
+
+	log.SetPrefix("debug [" + r.Name + "] - ")
+	log.SetFlags(0)
+
+	if env.Debug {
+		log.SetOutput(errOut)
+		log.Println("version:", Version())
+		log.Println("cfg - Debug:", env.Debug)
+		log.Println("cfg - Verbose:", env.Verbose)
+	} else {
+		log.SetOutput(io.Discard)
+	}
+
+	cfg, err := r.ParseConfig(config)
+	if err != nil {
+		return fmt.Errorf("parse config: %w", err)
+	}
+
+	entries, err := reader.ReadEntries()
+	if err != nil {
+		return fmt.Errorf("read entries: %w", err)
+	}
+
+	err = r.render(env, cfg, entries)
+	if err != nil {
+		var status ExitStatusError
+		if errors.As(err, &status) {
+			return status
+		}
+
+		return fmt.Errorf("render: %w", err)
+	}
+
+	return nil
+}
+
+func (r Report[C]) render(env Env, cfg C, entries Entries) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			var ok bool
+			if err, ok = rec.(error); !ok {
+				//nolint:err113
+				err = fmt.Errorf("non-error panic: %v", rec)
+			}
+		}
+	}()
+
+	return r.Render(env, cfg, entries)
+}
+
+// Main runs the [Report] with [os.Stdin], [os.Stdout] and [os.Stderr] as
+// timewarrior calls extensions. If an error occurs, it is printed to
+// [os.Stderr] and the program exits with status 1. For an [ExitStatusError],
+// the program exits with its status without printing anything.
+func (r Report[C]) Main() {
+	err := r.Run(os.Stdin, os.Stdout, os.Stderr)
+
+	var status ExitStatusError
+	if errors.As(err, &status) {
+		os.Exit(int(status))
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Version returns the module version of the main package as recorded in the
+// build info. It is empty if no build info is available.
+func Version() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	return buildInfo.Main.Version
+}
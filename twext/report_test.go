@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twext_test
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestRender = errors.New("render failed")
+
+func TestReport_Run(t *testing.T) {
+	const validInput = `verbose: on
+test.greeting: hello
+
+[
+{"id":2,"start":"20240630T143940Z","end":"20240630T143943Z"},
+{"id":1,"start":"20240630T144010Z"}
+]`
+
+	parseConfig := func(config twext.Config) (string, error) {
+		greeting, exists := config["test.greeting"]
+		if !exists {
+			return "", assert.AnError
+		}
+
+		log.Println("cfg - Greeting:", greeting)
+
+		return greeting.String(), nil
+	}
+
+	tests := []struct {
+		name           string
+		input          string
+		render         func(twext.Env, string, twext.Entries) error
+		expectedStdout string
+		expectedStderr string
+		expectedErr    error
+		expectedErrMsg string
+	}{
+		{
+			name:        "empty input",
+			expectedErr: twext.ErrConfigEmpty,
+		},
+		{
+			name:        "invalid config",
+			input:       "verbose: on\n\n[]",
+			expectedErr: assert.AnError,
+		},
+		{
+			name:           "invalid entries",
+			input:          "test.greeting: hello\n\n[{]",
+			expectedErrMsg: "read entries",
+		},
+		{
+			name:  "render error",
+			input: validInput,
+			render: func(twext.Env, string, twext.Entries) error {
+				return errTestRender
+			},
+			expectedErr: errTestRender,
+		},
+		{
+			name:  "render panic",
+			input: validInput,
+			render: func(twext.Env, string, twext.Entries) error {
+				panic(errTestRender)
+			},
+			expectedErr: errTestRender,
+		},
+		{
+			name:  "render non-error panic",
+			input: validInput,
+			render: func(twext.Env, string, twext.Entries) error {
+				panic("oops")
+			},
+			expectedErrMsg: "non-error panic: oops",
+		},
+		{
+			name:  "render",
+			input: validInput,
+			render: func(
+				env twext.Env,
+				cfg string,
+				entries twext.Entries,
+			) error {
+				log.Println("rendering")
+				_, err := fmt.Fprintln(env.Out, cfg, len(entries), env.Verbose)
+
+				return err
+			},
+			expectedStdout: "hello 2 true\n",
+		},
+		{
+			name:  "debug",
+			input: "debug: on\n" + validInput,
+			render: func(twext.Env, string, twext.Entries) error {
+				log.Println("rendering")
+
+				return nil
+			},
+			expectedStderr: `debug [test] - version: (devel)
+debug [test] - cfg - Debug: true
+debug [test] - cfg - Verbose: true
+debug [test] - cfg - Greeting: hello
+debug [test] - rendering
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+
+			report := twext.Report[string]{
+				Name:        "test",
+				ParseConfig: parseConfig,
+				Render:      tt.render,
+			}
+
+			err := report.Run(strings.NewReader(tt.input), &stdout, &stderr)
+
+			if tt.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.expectedStdout, stdout.String(), "stdout")
+			assert.Equal(t, tt.expectedStderr, stderr.String(), "stderr")
+		})
+	}
+}
+
+func TestReport_Run_ExitStatus(t *testing.T) {
+	report := twext.Report[string]{
+		Name: "test",
+		ParseConfig: func(twext.Config) (string, error) {
+			return "", nil
+		},
+		Render: func(env twext.Env, _ string, _ twext.Entries) error {
+			_, err := fmt.Fprintln(env.Out, "2 problems")
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("lint: %w", twext.ExitStatusError(3))
+		},
+	}
+
+	var stdout, stderr strings.Builder
+
+	err := report.Run(strings.NewReader("verbose: on\n\n[]"), &stdout, &stderr)
+	assert.Equal(t, twext.ExitStatusError(3), err, "error is not wrapped")
+	assert.EqualError(t, err, "exit status 3")
+	assert.Equal(t, "2 problems\n", stdout.String(), "stdout")
+	assert.Empty(t, stderr.String(), "stderr")
+}
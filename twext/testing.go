@@ -1,5 +0,0 @@
-// SPDX-FileCopyrightText: 2024 Tobias Böhm <code@aibor.de>
-//
-// SPDX-License-Identifier: GPL-3.0-or-later
-
-package twext
@@ -12,6 +12,18 @@ import (
 // DateFmt is the date format used by timewarrior.
 const DateFmt = "20060102T150405Z07"
 
+const noon = 12
+
+// Now returns the current time. It is used as end time of active entries.
+//
+// It can be replaced in tests for deterministic results, e.g. by the
+// FixedClock helper of the twexttest package. As it is a package variable,
+// replacing it is not synchronized with readers. Tests replacing it must not
+// run in parallel with tests that read it.
+//
+//nolint:gochecknoglobals
+var Now = time.Now
+
 // Time extends [time.Time] with a custom functionality.
 type Time struct {
 	time.Time
@@ -62,14 +74,21 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 }
 
 // SameDate compares two [Time] values and returns true if they are the same
-// date.
+// date in the location of t.
 func (t *Time) SameDate(o *Time) bool {
 	yt, mt, dt := t.Date()
-	yo, mo, do := o.Date()
+	yo, mo, do := o.In(t.Location()).Date()
 
 	return yt == yo && mt == mo && dt == do
 }
 
+// Midnight returns the start of the day of the given time in its location.
+func Midnight(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
 // setClock creates a new [time.Time] by overiding the clock parts of the given
 // base time with the given clock time.
 func setClock(base time.Time, clock time.Time) time.Time {
@@ -80,3 +99,11 @@ func setClock(base time.Time, clock time.Time) time.Time {
 
 	return time.Date(year, month, day, hour, minute, second, nsec, location)
 }
+
+// nextDay returns noon of the day after the given time's date. Noon is used
+// as it is not affected by daylight saving time transitions.
+func nextDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day+1, noon, 0, 0, 0, t.Location())
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSetClock(t *testing.T) {
@@ -44,3 +45,38 @@ func TestSetClock(t *testing.T) {
 		})
 	}
 }
+
+func TestNextDay(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "midnight",
+			input:    time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2015, 3, 2, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "end of month",
+			input:    time.Date(2015, 2, 28, 23, 59, 59, 0, time.UTC),
+			expected: time.Date(2015, 3, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "daylight saving time",
+			input:    time.Date(2024, 3, 30, 23, 30, 0, 0, berlin),
+			expected: time.Date(2024, 3, 31, 12, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := nextDay(tt.input)
+			assert.True(t, tt.expected.Equal(actual), actual)
+			assert.Equal(t, tt.expected.Location(), actual.Location())
+		})
+	}
+}
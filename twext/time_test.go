@@ -5,6 +5,10 @@
 package twext_test
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
@@ -189,6 +193,17 @@ func TestTimeSameDate(t *testing.T) {
 			dateA: twext.MustParseTime("20240630T112233Z"),
 			dateB: twext.MustParseTime("00010101T000000Z"),
 		},
+		{
+			name:        "same date in location of a",
+			dateA:       inBerlin("20240629T223000Z"),
+			dateB:       twext.MustParseTime("20240629T230000Z"),
+			expectEqual: true,
+		},
+		{
+			name:  "different date in location of a",
+			dateA: twext.MustParseTime("20240629T223000Z"),
+			dateB: inBerlin("20240630T080000Z"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -203,3 +218,59 @@ func TestTimeSameDate(t *testing.T) {
 		})
 	}
 }
+
+func TestMidnight(t *testing.T) {
+	actual := twext.Midnight(inBerlin("20240630T223000Z").Time)
+	assert.Equal(t, inBerlin("20240630T220000Z").Time, actual)
+
+	actual = twext.Midnight(twext.MustParseTime("20240630T223000Z").Time)
+	assert.Equal(t, twext.MustParseTime("20240630T000000Z").Time, actual)
+}
+
+func inBerlin(s string) twext.Time {
+	location, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		panic(err)
+	}
+
+	return twext.Time{twext.MustParseTime(s).In(location)}
+}
+
+func FuzzParseTime(f *testing.F) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "input_*.txt"))
+	require.NoError(f, err)
+
+	timestamp := regexp.MustCompile(`\d{8}T\d{6}Z`)
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		require.NoError(f, err)
+
+		for _, match := range timestamp.FindAllString(string(data), -1) {
+			f.Add(match)
+		}
+	}
+
+	f.Add("00010101T000000Z")
+	f.Add("20240630T143940+02")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		parsed, err := twext.ParseTime(input)
+		if err != nil {
+			return
+		}
+
+		reparsed, err := twext.ParseTime(parsed.Format(twext.DateFmt))
+		require.NoError(t, err)
+		assert.True(t, parsed.Equal(reparsed.Time))
+
+		var unmarshaled twext.Time
+
+		data, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		err = unmarshaled.UnmarshalJSON(data)
+		require.NoError(t, err)
+		assert.True(t, parsed.Equal(unmarshaled.Time))
+	})
+}
@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package twexttest provides utilities for testing timewarrior extensions
+// built with the twext package.
+//
+// Build the input of an extension with [NewInput], run the extension with
+// [Run] and compare its output with golden files by [AssertGolden]. Golden
+// files are updated by running the tests with the -update flag. Call [Main]
+// from TestMain to run the tests with UTC as local time zone.
+package twexttest
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twexttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+)
+
+type configLine struct {
+	key   twext.ConfigKey
+	value twext.ConfigValue
+}
+
+type inputEntry struct {
+	ID         int      `json:"id"`
+	Start      string   `json:"start"`
+	End        string   `json:"end,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Annotation string   `json:"annotation,omitempty"`
+}
+
+// Input is a builder for timewarrior extension input as described in
+// https://timewarrior.net/docs/api/#input-format.
+//
+// Entries are defined relative to a reference time, which usually is the
+// time set by [FixedClock]. IDs are assigned like timewarrior does: the last
+// added entry has ID 1.
+type Input struct {
+	now     time.Time
+	config  []configLine
+	entries []inputEntry
+}
+
+// NewInput creates a new [Input] with the given reference time.
+func NewInput(now time.Time) *Input {
+	return &Input{now: now}
+}
+
+// Config adds a config line. Lines are written in the order they are added.
+func (i *Input) Config(key twext.ConfigKey, value twext.ConfigValue) *Input {
+	i.config = append(i.config, configLine{key, value})
+
+	return i
+}
+
+// Entry adds a closed entry that starts and ends at the given offsets
+// relative to the reference time. Use negative offsets for the past.
+func (i *Input) Entry(start, end time.Duration, tags ...string) *Input {
+	return i.EntryAt(i.now.Add(start), i.now.Add(end), tags...)
+}
+
+// OpenEntry adds an active entry that starts at the given offset relative to
+// the reference time.
+func (i *Input) OpenEntry(start time.Duration, tags ...string) *Input {
+	return i.EntryAt(i.now.Add(start), time.Time{}, tags...)
+}
+
+// EntryAt adds an entry with absolute start and end times. If end is the
+// zero time, the entry is active.
+func (i *Input) EntryAt(start, end time.Time, tags ...string) *Input {
+	entry := inputEntry{
+		Start: formatTime(start),
+		Tags:  tags,
+	}
+
+	if !end.IsZero() {
+		entry.End = formatTime(end)
+	}
+
+	i.entries = append(i.entries, entry)
+
+	return i
+}
+
+// Annotate sets the annotation of the last added entry. It panics if no entry
+// has been added yet.
+func (i *Input) Annotate(annotation string) *Input {
+	i.entries[len(i.entries)-1].Annotation = annotation
+
+	return i
+}
+
+// String returns the input in timewarrior format.
+func (i *Input) String() string {
+	str := &strings.Builder{}
+
+	for _, line := range i.config {
+		_, _ = fmt.Fprintf(str, "%s: %s\n", line.key, line.value)
+	}
+
+	str.WriteString("\n[\n")
+
+	for idx, entry := range i.entries {
+		entry.ID = len(i.entries) - idx
+
+		data, err := json.Marshal(entry)
+		if err != nil {
+			panic(fmt.Errorf("marshal entry: %w", err))
+		}
+
+		str.Write(data)
+
+		if idx < len(i.entries)-1 {
+			str.WriteString(",")
+		}
+
+		str.WriteString("\n")
+	}
+
+	str.WriteString("]\n")
+
+	return str.String()
+}
+
+// Reader returns a reader for the input in timewarrior format.
+func (i *Input) Reader() *strings.Reader {
+	return strings.NewReader(i.String())
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(twext.DateFmt)
+}
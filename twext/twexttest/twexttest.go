@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twexttest
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	goldenDir       = "testdata"
+	goldenExtension = ".golden"
+	goldenDirPerm   = 0o755
+	goldenFilePerm  = 0o644
+)
+
+//nolint:gochecknoglobals
+var update = flag.Bool("update", false, "update golden files")
+
+// Main runs the tests of a package with [time.Local] set to UTC and exits with
+// their status. This makes the default time zone "local" of extensions
+// independent of the test environment. Call it from the package's TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		twexttest.Main(m)
+//	}
+func Main(m *testing.M) {
+	time.Local = time.UTC
+
+	os.Exit(m.Run())
+}
+
+// FixedClock sets [twext.Now] to return the given time until the test and
+// all its subtests are completed.
+//
+// As it replaces a package variable, it races with all tests of the package
+// that run in parallel. Neither tests using it nor their subtests may call
+// [testing.T.Parallel], and no parallel tests may run while it is in effect.
+func FixedClock(tb testing.TB, now time.Time) {
+	tb.Helper()
+
+	original := twext.Now
+	twext.Now = func() time.Time {
+		return now
+	}
+
+	tb.Cleanup(func() {
+		twext.Now = original
+	})
+}
+
+// AssertGolden compares the actual output with the content of the golden file
+// testdata/<name>.golden relative to the test's package directory.
+//
+// If the tests run with the -update flag, the golden file is written with the
+// actual output instead.
+func AssertGolden(tb testing.TB, name string, actual string) bool {
+	tb.Helper()
+
+	path := filepath.Join(goldenDir, name+goldenExtension)
+
+	if *update {
+		err := os.MkdirAll(filepath.Dir(path), goldenDirPerm)
+		require.NoError(tb, err, "create golden file directory")
+
+		err = os.WriteFile(path, []byte(actual), goldenFilePerm)
+		require.NoError(tb, err, "write golden file")
+
+		return true
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(tb, err, "read golden file (use -update to create it)")
+
+	return assert.Equal(tb, string(expected), actual, "golden file %s", path)
+}
+
+// RunFunc runs an extension with the given input and outputs, like
+// [twext.Report.Run].
+type RunFunc func(in io.ReadSeeker, out, errOut io.Writer) error
+
+// Result is the result of an extension run.
+type Result struct {
+	Stdout string
+	Stderr string
+	Err    error
+}
+
+// Run runs the given [RunFunc] with the given input and returns the collected
+// outputs and the returned error.
+func Run(run RunFunc, input string) Result {
+	var stdout, stderr strings.Builder
+
+	err := run(strings.NewReader(input), &stdout, &stderr)
+
+	return Result{
+		Stdout: stdout.String(),
+		Stderr: stderr.String(),
+		Err:    err,
+	}
+}
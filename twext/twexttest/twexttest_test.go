@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2026 Tobias Böhm <code@aibor.de>
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package twexttest_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aibor/timewarrior-extensions/twext"
+	"github.com/aibor/timewarrior-extensions/twext/twexttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2024, 6, 30, 14, 0, 0, 0, time.UTC)
+
+func newTestInput() *twexttest.Input {
+	return twexttest.NewInput(testNow).
+		Config(twext.ConfigKeyVerbose, "on").
+		Config("test.key", "value").
+		Entry(-26*time.Hour, -25*time.Hour, "a", "b").
+		EntryAt(
+			time.Date(2024, 6, 30, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 30, 9, 30, 0, 0, time.UTC),
+		).
+		Annotate("some note").
+		OpenEntry(-90*time.Minute, "c")
+}
+
+func TestInput(t *testing.T) {
+	input := newTestInput()
+
+	twexttest.AssertGolden(t, "input", input.String())
+
+	reader := twext.NewReader(input.Reader())
+
+	config, err := reader.ReadConfig()
+	require.NoError(t, err)
+
+	expectedConfig := twext.Config{
+		"verbose":  "on",
+		"test.key": "value",
+	}
+	assert.Equal(t, expectedConfig, config)
+
+	entries, err := reader.ReadEntries()
+	require.NoError(t, err)
+
+	expectedEntries := twext.Entries{
+		{
+			ID:    3,
+			Start: twext.MustParseTime("20240629T120000Z"),
+			End:   twext.MustParseTime("20240629T130000Z"),
+			Tags:  []string{"a", "b"},
+		},
+		{
+			ID:         2,
+			Start:      twext.MustParseTime("20240630T090000Z"),
+			End:        twext.MustParseTime("20240630T093000Z"),
+			Annotation: "some note",
+		},
+		{
+			ID:    1,
+			Start: twext.MustParseTime("20240630T123000Z"),
+			Tags:  []string{"c"},
+		},
+	}
+	assert.Equal(t, expectedEntries, entries)
+}
+
+func TestInput_Empty(t *testing.T) {
+	input := twexttest.NewInput(testNow)
+	assert.Equal(t, "\n[\n]\n", input.String())
+}
+
+func TestFixedClock(t *testing.T) {
+	t.Run("fixed", func(t *testing.T) {
+		twexttest.FixedClock(t, testNow)
+
+		assert.Equal(t, testNow, twext.Now())
+
+		entry := twext.Entry{Start: twext.Time{Time: testNow.Add(-time.Hour)}}
+		assert.Equal(t, time.Hour, entry.Duration())
+	})
+
+	assert.NotEqual(t, testNow, twext.Now(), "restored")
+}
+
+func TestRun(t *testing.T) {
+	twexttest.FixedClock(t, testNow)
+
+	report := twext.Report[string]{
+		Name: "test",
+		ParseConfig: func(config twext.Config) (string, error) {
+			return config["test.key"].String(), nil
+		},
+		Render: func(env twext.Env, cfg string, entries twext.Entries) error {
+			for _, entry := range entries {
+				_, err := fmt.Fprintln(env.Out, cfg, entry.ID, entry.Duration())
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err := fmt.Fprintln(env.Err, "done")
+
+			return err
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		result := twexttest.Run(report.Run, newTestInput().String())
+		require.NoError(t, result.Err)
+
+		expectedStdout := "value 3 1h0m0s\nvalue 2 30m0s\nvalue 1 1h30m0s\n"
+		assert.Equal(t, expectedStdout, result.Stdout)
+		assert.Equal(t, "done\n", result.Stderr)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		result := twexttest.Run(report.Run, "")
+		require.ErrorIs(t, result.Err, twext.ErrConfigEmpty)
+		assert.Empty(t, result.Stdout)
+	})
+}